@@ -4,13 +4,26 @@ package main
 
 import (
 	_ "embed"
+	"flag"
 	"flappy-go/internal/core"
 	"flappy-go/internal/scenes"
 )
 
 func main() {
+	seed := flag.Int64("seed", 0, "random seed to replay a session, 0 for a time based seed")
+	renderRate := flag.Int("render-rate", core.Game_DefaultRenderRate, "frames drawn per second, 0 for no limit")
+	updateRate := flag.Int("update-rate", core.Game_DefaultUpdateRate, "fixed updates per second, 0 to update once per frame")
+	flag.Parse()
+
 	// Create a new game instance
-	g := core.NewGame(860, 540, "Flappy Go", 60)
+	g := core.NewGame(
+		860, 540, "Flappy Go",
+		core.WithRenderRate(int32(*renderRate)),
+		core.WithUpdateRate(int32(*updateRate)),
+	)
+	if *seed != 0 {
+		g.SetSeed(*seed)
+	}
 	g.Initialize()
 	defer g.Cleanup()
 	// Create and set the main scene
@@ -0,0 +1,33 @@
+// Golden image regeneration for the flappy-go.
+// The golden images are checked by the TestGolden test of the scenes package,
+// this program rewrites them after an intended visual change.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+const (
+	Golden_Package = "./internal/scenes"
+	Golden_Test    = "^TestGolden$"
+)
+
+func main() {
+	run := flag.String("run", "", "only rewrite the cases whose name matches this regular expression")
+	flag.Parse()
+
+	pattern := Golden_Test
+	if *run != "" {
+		pattern += "/" + *run
+	}
+	cmd := exec.Command("go", "test", Golden_Package, "-count=1", "-run", pattern, "-args", "-update")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "golden: cannot rewrite the golden images: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -83,12 +83,12 @@ func (as *AnimatedSprite) Update(dt float32) {
 	as.animations[as.currentAnimation] = anim
 }
 
-func (as *AnimatedSprite) Draw(transform Transform) {
+func (as *AnimatedSprite) Draw(r Renderer, transform Transform) {
 	if as.currentAnimation == "" {
 		return
 	}
 	anim := as.animations[as.currentAnimation]
 	if anim.currentFrame >= 0 && anim.currentFrame < len(anim.sprites) {
-		anim.sprites[anim.currentFrame].Draw(transform)
+		anim.sprites[anim.currentFrame].Draw(r, transform)
 	}
 }
@@ -12,8 +12,8 @@ type Drawer interface {
 	Hide()
 	// ZIndex returns the rendering layer index. Lower values are drawn first.
 	ZIndex() int
-	// Draw renders the drawer to the screen.
-	Draw()
+	// Draw renders the drawer with a renderer.
+	Draw(r Renderer)
 }
 
 // BaseDrawer provides a basic implementation of the Drawable interface.
@@ -33,7 +33,7 @@ func (bd BaseDrawer) ZIndex() int {
 }
 
 // Draw provides a default empty implementation of the Draw method.
-func (bd BaseDrawer) Draw() {}
+func (bd BaseDrawer) Draw(r Renderer) {}
 
 // Visible returns whether the drawable is currently visible.
 func (bd BaseDrawer) Visible() bool {
@@ -3,28 +3,92 @@
 package core
 
 import (
+	"math/rand"
+	"time"
+
 	raylib "github.com/gen2brain/raylib-go/raylib"
 )
 
 // Game represents the main game instance.
 // It manages the game window, scene, and main game loop.
 type Game struct {
-	root   Entity
-	width  int32
-	height int32
-	title  string
-	fps    int32
+	root         Entity
+	width        int32
+	height       int32
+	title        string
+	renderer     Renderer
+	renderRate   int32
+	debugKey     int32
+	physicsDebug bool
+	seed         int64
+	rng          *rand.Rand
+	updateRate   int32
+	maxFrameTime float32
+	accumulator  float32
+	alpha        float32
+}
+
+// GameOption configures a game created by NewGame.
+type GameOption func(*Game)
+
+const (
+	Game_DefaultRenderRate   = 60
+	Game_DefaultUpdateRate   = 60
+	Game_DefaultMaxFrameTime = 0.25
+)
+
+// updateAlpha is the update alpha of the running game, see UpdateAlpha.
+var updateAlpha float32 = 1
+
+// WithRenderRate sets the number of frames drawn per second targeted by the
+// renderer. 0 draws as fast as possible.
+func WithRenderRate(fps int32) GameOption {
+	return func(g *Game) {
+		g.renderRate = fps
+	}
+}
+
+// WithUpdateRate sets the number of fixed updates per second, independent of
+// the render rate. 0 updates once per frame with the frame time instead.
+func WithUpdateRate(rate int32) GameOption {
+	return func(g *Game) {
+		g.updateRate = rate
+	}
+}
+
+// WithMaxFrameTime sets the longest frame time in seconds that is simulated,
+// so a hitch slows the game down instead of producing a huge update.
+func WithMaxFrameTime(seconds float32) GameOption {
+	return func(g *Game) {
+		g.maxFrameTime = seconds
+	}
 }
 
 // NewGame creates a new game instance with the specified parameters.
-func NewGame(width, height int32, title string, fps int32) *Game {
-	return &Game{
-		root:   nil,
-		width:  width,
-		height: height,
-		title:  title,
-		fps:    fps,
+// The game draws 60 frames per second to a raylib window, see WithRenderRate and
+// SetRenderer, and updates 60 times per second, see WithUpdateRate.
+// The random number generator is seeded from the current time, see SetSeed.
+func NewGame(width, height int32, title string, options ...GameOption) *Game {
+	seed := time.Now().UnixNano()
+	g := &Game{
+		root:         nil,
+		width:        width,
+		height:       height,
+		title:        title,
+		renderer:     NewRaylibRenderer(),
+		renderRate:   Game_DefaultRenderRate,
+		debugKey:     raylib.KeyF3,
+		physicsDebug: false,
+		seed:         seed,
+		rng:          rand.New(rand.NewSource(seed)),
+		updateRate:   Game_DefaultUpdateRate,
+		maxFrameTime: Game_DefaultMaxFrameTime,
+		alpha:        1,
+	}
+	for _, option := range options {
+		option(g)
 	}
+	return g
 }
 
 // Root returns the current scene.
@@ -33,50 +97,147 @@ func (g *Game) Root() Entity {
 }
 
 // SetRoot assigns a scene to the game instance.
+// A root scene draws its random numbers from the game generator.
 func (g *Game) SetRoot(e Entity) {
 	if g.root != nil {
 		g.root.removed()
 	}
 	g.root = e
+	if scene, ok := g.root.(*Scene); ok {
+		scene.SetRand(g.rng)
+	}
 	if g.root != nil {
 		g.root.added()
 	}
 }
 
-// Initialize sets up the game window and initializes raylib.
+// Renderer returns the renderer drawing the game.
+func (g *Game) Renderer() Renderer {
+	return g.renderer
+}
+
+// SetRenderer sets the renderer drawing the game, e.g. a headless one to run
+// without a window. Call it before Initialize, which applies the render rate.
+func (g *Game) SetRenderer(r Renderer) {
+	g.renderer = r
+}
+
+// Seed returns the seed of the game random number generator.
+func (g *Game) Seed() int64 {
+	return g.seed
+}
+
+// SetSeed reseeds the game random number generator, so a whole session can be
+// reproduced from the same seed. Call it before SetRoot.
+func (g *Game) SetSeed(seed int64) {
+	g.seed = seed
+	g.rng.Seed(seed)
+}
+
+// PhysicsDebug reports whether the physics debug overlay is drawn.
+func (g *Game) PhysicsDebug() bool {
+	return g.physicsDebug
+}
+
+// SetPhysicsDebug shows or hides the physics debug overlay.
+func (g *Game) SetPhysicsDebug(enabled bool) {
+	g.physicsDebug = enabled
+}
+
+// SetDebugKey sets the key toggling the physics debug overlay. Defaults to F3,
+// raylib.KeyNull disables the toggle.
+func (g *Game) SetDebugKey(key int32) {
+	g.debugKey = key
+}
+
+// Initialize sets up the renderer at the render rate and makes its screen the one
+// queried by entities.
 // This should be called before Run().
 func (g *Game) Initialize() {
-	raylib.InitWindow(g.width, g.height, g.title)
-	raylib.SetTargetFPS(g.fps)
-	raylib.InitAudioDevice()
+	g.renderer.SetRenderRate(g.renderRate)
+	g.renderer.Init(g.width, g.height, g.title)
+	activeRenderer = g.renderer
+	// Log the seed so the session can be replayed
+	raylib.TraceLog(raylib.LogInfo, "game: random seed %d", g.seed)
 }
 
-// Cleanup properly closes the game window and cleans up resources.
+// Cleanup properly closes the renderer and cleans up resources.
 // This should be called when the game is shutting down.
 func (g *Game) Cleanup() {
 	g.SetRoot(nil)
-	raylib.CloseAudioDevice()
-	raylib.CloseWindow()
+	g.renderer.Close()
+	if activeRenderer == g.renderer {
+		activeRenderer = nil
+		updateAlpha = 1
+	}
 }
 
 // Run starts the main game loop.
-// This will block until the game window is closed.
+// Each frame, the elapsed time is clamped to the max frame time and consumed by
+// fixed updates, then the frame is drawn once, see UpdateAlpha.
+// This will block until the renderer asks to close, e.g. the game window is closed.
 func (g *Game) Run() {
-	for !raylib.WindowShouldClose() {
-		deltaTime := raylib.GetFrameTime()
-		if g.root != nil {
-			// Update
-			if updater, ok := g.root.(Updater); ok {
-				updater.Update(deltaTime)
+	g.accumulator = 0
+	g.setAlpha(1)
+	for !g.renderer.ShouldClose() {
+		frameTime := g.renderer.FrameTime()
+		if g.maxFrameTime > 0 {
+			frameTime = min(frameTime, g.maxFrameTime)
+		}
+		pollInput()
+		if g.updateRate <= 0 {
+			g.update(frameTime)
+		} else {
+			step := 1 / float32(g.updateRate)
+			g.accumulator += frameTime
+			for g.accumulator >= step {
+				g.update(step)
+				g.accumulator -= step
 			}
-
-			// Render
-			raylib.BeginDrawing()
-			raylib.ClearBackground(raylib.RayWhite)
+			// The time left over draws the frame between the last two updates
+			g.setAlpha(g.accumulator / step)
+		}
+		if g.root != nil {
+			g.renderer.BeginFrame()
+			g.renderer.Clear(raylib.RayWhite)
 			if drawer, ok := g.root.(Drawer); ok {
-				drawer.Draw()
+				drawer.Draw(g.renderer)
 			}
-			raylib.EndDrawing()
+			if scene, ok := g.root.(*Scene); ok && g.physicsDebug {
+				scene.DrawPhysicsDebug(g.renderer)
+			}
+			g.renderer.EndFrame()
 		}
 	}
 }
+
+// UpdateAlpha returns how far the frame being drawn is between the previous
+// update and the last one, from 0 to 1. Drawers interpolate their state with it
+// so motion stays smooth when the render and update rates differ.
+// It is always 1 when updating once per frame.
+func (g *Game) UpdateAlpha() float32 {
+	return g.alpha
+}
+
+// UpdateAlpha returns the update alpha of the running game, see Game.UpdateAlpha.
+// It is 1 outside of a game.
+func UpdateAlpha() float32 {
+	return updateAlpha
+}
+
+// setAlpha sets the update alpha of the game, also returned by UpdateAlpha.
+func (g *Game) setAlpha(alpha float32) {
+	g.alpha = min(max(alpha, 0), 1)
+	updateAlpha = g.alpha
+}
+
+// update handles the input collected since the previous update and updates the root.
+func (g *Game) update(dt float32) {
+	if g.debugKey != raylib.KeyNull && IsKeyPressed(g.debugKey) {
+		g.physicsDebug = !g.physicsDebug
+	}
+	if updater, ok := g.root.(Updater); ok {
+		updater.Update(dt)
+	}
+	consumeInput()
+}
@@ -0,0 +1,68 @@
+package core
+
+import "testing"
+
+func TestUpdateAlphaBetweenFixedUpdates(t *testing.T) {
+	// Two frames per update: every other frame is drawn half way between two updates
+	renderer := NewHeadlessRenderer(100, 100, 1.0/120)
+	renderer.MaxFrames = 4
+	g := NewGame(100, 100, "test", WithUpdateRate(60))
+	g.SetRenderer(renderer)
+	g.Initialize()
+	defer g.Cleanup()
+
+	updates := 0
+	root := NewScene(nil, "root", nil, 0)
+	root.Add(newTestUpdater(root, func(dt float32) { updates++ }))
+	g.SetRoot(root)
+
+	var alphas []float32
+	renderer.OnFrame = func(frame int, commands []DrawCommand) {
+		alphas = append(alphas, UpdateAlpha())
+	}
+	g.Run()
+
+	want := []float32{0.5, 0, 0.5, 0}
+	for i := range want {
+		if diff := alphas[i] - want[i]; diff > 1e-4 || diff < -1e-4 {
+			t.Errorf("frame %d: UpdateAlpha() = %v, want %v", i+1, alphas[i], want[i])
+		}
+	}
+	if updates != 2 {
+		t.Errorf("updated %d times in 4 frames, want 2", updates)
+	}
+}
+
+func TestUpdateAlphaWithoutFixedUpdates(t *testing.T) {
+	renderer := NewHeadlessRenderer(100, 100, 1.0/120)
+	renderer.MaxFrames = 2
+	g := NewGame(100, 100, "test", WithUpdateRate(0))
+	g.SetRenderer(renderer)
+	g.Initialize()
+	defer g.Cleanup()
+	g.SetRoot(NewScene(nil, "root", nil, 0))
+	g.Run()
+
+	if alpha := g.UpdateAlpha(); alpha != 1 {
+		t.Errorf("UpdateAlpha() = %v, want 1", alpha)
+	}
+}
+
+// testUpdater is an entity calling a function on every update.
+type testUpdater struct {
+	*BaseEntity
+	*BaseUpdater
+	update func(dt float32)
+}
+
+func newTestUpdater(parent *Scene, update func(dt float32)) *testUpdater {
+	return &testUpdater{
+		BaseEntity:  NewBaseEntity(parent, "updater", nil),
+		BaseUpdater: NewBaseUpdater(),
+		update:      update,
+	}
+}
+
+func (u *testUpdater) Update(dt float32) {
+	u.update(dt)
+}
@@ -0,0 +1,185 @@
+package core
+
+import (
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+// DrawCommandType identifies the renderer call recorded by a DrawCommand.
+type DrawCommandType int
+
+const (
+	DrawCommandClear DrawCommandType = iota
+	DrawCommandTexture
+	DrawCommandRectangle
+	DrawCommandLine
+	DrawCommandCircle
+	DrawCommandCircleLines
+	DrawCommandText
+)
+
+// DrawCommand is a renderer call recorded by the headless renderer.
+// Only the fields used by its type are set.
+type DrawCommand struct {
+	Type     DrawCommandType
+	Texture  *Texture
+	Source   raylib.Rectangle
+	Dest     raylib.Rectangle
+	Origin   raylib.Vector2
+	Rotation float32
+	From     raylib.Vector2
+	To       raylib.Vector2
+	Radius   float32
+	Text     string
+	FontSize int32
+	Color    raylib.Color
+}
+
+// HeadlessRenderer records the draw calls of every frame instead of drawing them,
+// so the game can run without a window or a GPU.
+// Frames take a fixed time, and the game stops after MaxFrames frames.
+type HeadlessRenderer struct {
+	// MaxFrames is the number of frames after which ShouldClose returns true, 0 to never stop.
+	MaxFrames int
+	// OnFrame is called with the commands of every finished frame, if set.
+	OnFrame   func(frame int, commands []DrawCommand)
+	width     int32
+	height    int32
+	frameTime float32
+	frames    int
+	commands  []DrawCommand
+	closed    bool
+}
+
+// NewHeadlessRenderer creates a headless renderer with a screen size and a fixed frame time in seconds.
+func NewHeadlessRenderer(width, height int32, frameTime float32) *HeadlessRenderer {
+	return &HeadlessRenderer{
+		width:     width,
+		height:    height,
+		frameTime: frameTime,
+	}
+}
+
+// Init resizes the screen, the title is ignored.
+func (r *HeadlessRenderer) Init(width, height int32, title string) {
+	r.width = width
+	r.height = height
+	r.closed = false
+}
+
+// SetRenderRate is ignored, frames take the fixed frame time.
+func (r *HeadlessRenderer) SetRenderRate(fps int32) {}
+
+// Close makes ShouldClose return true.
+func (r *HeadlessRenderer) Close() {
+	r.closed = true
+}
+
+// ShouldClose reports whether the renderer was closed or MaxFrames frames were drawn.
+func (r *HeadlessRenderer) ShouldClose() bool {
+	return r.closed || (r.MaxFrames > 0 && r.frames >= r.MaxFrames)
+}
+
+// FrameTime returns the fixed frame time.
+func (r *HeadlessRenderer) FrameTime() float32 {
+	return r.frameTime
+}
+
+// ScreenWidth returns the width of the screen.
+func (r *HeadlessRenderer) ScreenWidth() int32 {
+	return r.width
+}
+
+// ScreenHeight returns the height of the screen.
+func (r *HeadlessRenderer) ScreenHeight() int32 {
+	return r.height
+}
+
+// Frames returns the number of finished frames.
+func (r *HeadlessRenderer) Frames() int {
+	return r.frames
+}
+
+// Commands returns the commands recorded since the beginning of the current frame,
+// or of the last finished frame when called between frames.
+func (r *HeadlessRenderer) Commands() []DrawCommand {
+	return r.commands
+}
+
+// BeginFrame forgets the commands of the previous frame.
+func (r *HeadlessRenderer) BeginFrame() {
+	clear(r.commands)
+	r.commands = r.commands[:0]
+}
+
+// EndFrame counts the frame and hands its commands to OnFrame.
+func (r *HeadlessRenderer) EndFrame() {
+	r.frames++
+	if r.OnFrame != nil {
+		r.OnFrame(r.frames, r.commands)
+	}
+}
+
+// Clear records a clear command.
+func (r *HeadlessRenderer) Clear(color raylib.Color) {
+	r.commands = append(r.commands, DrawCommand{Type: DrawCommandClear, Color: color})
+}
+
+// DrawTexture records a texture command.
+func (r *HeadlessRenderer) DrawTexture(
+	texture *Texture,
+	source, dest raylib.Rectangle,
+	origin raylib.Vector2,
+	rotation float32,
+	tint raylib.Color,
+) {
+	r.commands = append(r.commands, DrawCommand{
+		Type:     DrawCommandTexture,
+		Texture:  texture,
+		Source:   source,
+		Dest:     dest,
+		Origin:   origin,
+		Rotation: rotation,
+		Color:    tint,
+	})
+}
+
+// DrawRectangle records a rectangle command.
+func (r *HeadlessRenderer) DrawRectangle(rect raylib.Rectangle, color raylib.Color) {
+	r.commands = append(r.commands, DrawCommand{Type: DrawCommandRectangle, Dest: rect, Color: color})
+}
+
+// DrawLine records a line command.
+func (r *HeadlessRenderer) DrawLine(from, to raylib.Vector2, color raylib.Color) {
+	r.commands = append(r.commands, DrawCommand{Type: DrawCommandLine, From: from, To: to, Color: color})
+}
+
+// DrawCircle records a circle command.
+func (r *HeadlessRenderer) DrawCircle(center raylib.Vector2, radius float32, color raylib.Color) {
+	r.commands = append(r.commands, DrawCommand{
+		Type:   DrawCommandCircle,
+		From:   center,
+		Radius: radius,
+		Color:  color,
+	})
+}
+
+// DrawCircleLines records a circle outline command.
+func (r *HeadlessRenderer) DrawCircleLines(center raylib.Vector2, radius float32, color raylib.Color) {
+	r.commands = append(r.commands, DrawCommand{
+		Type:   DrawCommandCircleLines,
+		From:   center,
+		Radius: radius,
+		Color:  color,
+	})
+}
+
+// DrawText records a text command.
+func (r *HeadlessRenderer) DrawText(text string, x, y, fontSize int32, color raylib.Color) {
+	r.commands = append(r.commands, DrawCommand{
+		Type:     DrawCommandText,
+		From:     raylib.NewVector2(float32(x), float32(y)),
+		Text:     text,
+		FontSize: fontSize,
+		Color:    color,
+	})
+}
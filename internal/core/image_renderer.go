@@ -0,0 +1,277 @@
+package core
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+// ImageRenderer rasterizes frames in software into an image, so screenshots and
+// golden images can be produced without a window or a GPU.
+// It records the draw calls like the headless renderer it builds on,
+// and samples textures like raylib does: nearest pixel, repeated past the edges.
+// Text is recorded but not rasterized, raylib default font is not available in Go.
+type ImageRenderer struct {
+	*HeadlessRenderer
+	// OnImage is called with the image of every finished frame, if set.
+	// The image is reused by the next frame, copy it to keep it.
+	OnImage  func(frame int, img *image.RGBA)
+	frame    *image.RGBA
+	textures map[*Texture]*image.NRGBA
+}
+
+// NewImageRenderer creates an image renderer with a screen size and a fixed frame time in seconds.
+func NewImageRenderer(width, height int32, frameTime float32) *ImageRenderer {
+	return &ImageRenderer{
+		HeadlessRenderer: NewHeadlessRenderer(width, height, frameTime),
+		frame:            image.NewRGBA(image.Rect(0, 0, int(width), int(height))),
+		textures:         make(map[*Texture]*image.NRGBA),
+	}
+}
+
+// Init resizes the screen and its image, the title is ignored.
+func (r *ImageRenderer) Init(width, height int32, title string) {
+	r.HeadlessRenderer.Init(width, height, title)
+	r.frame = image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+}
+
+// Close forgets the decoded textures.
+func (r *ImageRenderer) Close() {
+	r.HeadlessRenderer.Close()
+	clear(r.textures)
+}
+
+// EndFrame counts the frame and hands its commands to OnFrame and its image to OnImage.
+func (r *ImageRenderer) EndFrame() {
+	r.HeadlessRenderer.EndFrame()
+	if r.OnImage != nil {
+		r.OnImage(r.Frames(), r.frame)
+	}
+}
+
+// Image returns the image of the current frame, or of the last finished frame
+// when called between frames. The image is reused by the next frame.
+func (r *ImageRenderer) Image() *image.RGBA {
+	return r.frame
+}
+
+// WritePNG encodes the image of the current frame as PNG.
+func (r *ImageRenderer) WritePNG(w io.Writer) error {
+	return png.Encode(w, r.frame)
+}
+
+// Clear records a clear command and fills the image with a color.
+func (r *ImageRenderer) Clear(color raylib.Color) {
+	r.HeadlessRenderer.Clear(color)
+	alpha := uint32(color.A)
+	premultiplied := [4]uint8{
+		uint8(uint32(color.R) * alpha / 255),
+		uint8(uint32(color.G) * alpha / 255),
+		uint8(uint32(color.B) * alpha / 255),
+		color.A,
+	}
+	for i := 0; i < len(r.frame.Pix); i += 4 {
+		copy(r.frame.Pix[i:i+4], premultiplied[:])
+	}
+}
+
+// DrawTexture records a texture command and rasterizes it.
+func (r *ImageRenderer) DrawTexture(
+	texture *Texture,
+	source, dest raylib.Rectangle,
+	origin raylib.Vector2,
+	rotation float32,
+	tint raylib.Color,
+) {
+	r.HeadlessRenderer.DrawTexture(texture, source, dest, origin, rotation, tint)
+	src := r.texture(texture)
+	if src == nil || dest.Width <= 0 || dest.Height <= 0 || source.Width == 0 || source.Height == 0 {
+		return
+	}
+	texWidth := src.Bounds().Dx()
+	texHeight := src.Bounds().Dy()
+	sin, cos := math.Sincos(float64(rotation) * math.Pi / 180)
+
+	// Bounding box of the rotated dest rectangle on screen
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, corner := range [4][2]float64{
+		{0, 0},
+		{float64(dest.Width), 0},
+		{0, float64(dest.Height)},
+		{float64(dest.Width), float64(dest.Height)},
+	} {
+		dx := corner[0] - float64(origin.X)
+		dy := corner[1] - float64(origin.Y)
+		x := float64(dest.X) + dx*cos - dy*sin
+		y := float64(dest.Y) + dx*sin + dy*cos
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+
+	// Map every covered pixel center back to the source rectangle
+	srcWidth := math.Abs(float64(source.Width))
+	srcHeight := math.Abs(float64(source.Height))
+	bounds := r.clip(minX, minY, maxX, maxY)
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			dx := float64(px) + 0.5 - float64(dest.X)
+			dy := float64(py) + 0.5 - float64(dest.Y)
+			u := (dx*cos + dy*sin + float64(origin.X)) / float64(dest.Width)
+			v := (-dx*sin + dy*cos + float64(origin.Y)) / float64(dest.Height)
+			if u < 0 || u >= 1 || v < 0 || v >= 1 {
+				continue
+			}
+			// Negative source sizes flip the texture, as in raylib
+			if source.Width < 0 {
+				u = 1 - u
+			}
+			if source.Height < 0 {
+				v = 1 - v
+			}
+			tx := wrap(int(math.Floor(float64(source.X)+u*srcWidth)), texWidth)
+			ty := wrap(int(math.Floor(float64(source.Y)+v*srcHeight)), texHeight)
+			texel := src.NRGBAAt(src.Bounds().Min.X+tx, src.Bounds().Min.Y+ty)
+			r.blend(px, py, raylib.Color{
+				R: uint8(uint16(texel.R) * uint16(tint.R) / 255),
+				G: uint8(uint16(texel.G) * uint16(tint.G) / 255),
+				B: uint8(uint16(texel.B) * uint16(tint.B) / 255),
+				A: uint8(uint16(texel.A) * uint16(tint.A) / 255),
+			})
+		}
+	}
+}
+
+// DrawRectangle records a rectangle command and fills it.
+func (r *ImageRenderer) DrawRectangle(rect raylib.Rectangle, color raylib.Color) {
+	r.HeadlessRenderer.DrawRectangle(rect, color)
+	bounds := r.clip(
+		float64(rect.X),
+		float64(rect.Y),
+		float64(rect.X+rect.Width),
+		float64(rect.Y+rect.Height),
+	)
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			r.blend(px, py, color)
+		}
+	}
+}
+
+// DrawLine records a line command and draws it one pixel wide.
+func (r *ImageRenderer) DrawLine(from, to raylib.Vector2, color raylib.Color) {
+	r.HeadlessRenderer.DrawLine(from, to, color)
+	dx := float64(to.X - from.X)
+	dy := float64(to.Y - from.Y)
+	steps := int(math.Ceil(math.Max(math.Abs(dx), math.Abs(dy))))
+	for i := 0; i <= steps; i++ {
+		t := 0.0
+		if steps > 0 {
+			t = float64(i) / float64(steps)
+		}
+		r.blend(
+			int(math.Floor(float64(from.X)+dx*t)),
+			int(math.Floor(float64(from.Y)+dy*t)),
+			color,
+		)
+	}
+}
+
+// DrawCircle records a circle command and fills it.
+func (r *ImageRenderer) DrawCircle(center raylib.Vector2, radius float32, color raylib.Color) {
+	r.HeadlessRenderer.DrawCircle(center, radius, color)
+	r.circle(center, radius, color, func(distance float64) bool {
+		return distance <= float64(radius)
+	})
+}
+
+// DrawCircleLines records a circle outline command and draws it one pixel wide.
+func (r *ImageRenderer) DrawCircleLines(center raylib.Vector2, radius float32, color raylib.Color) {
+	r.HeadlessRenderer.DrawCircleLines(center, radius, color)
+	r.circle(center, radius+0.5, color, func(distance float64) bool {
+		return math.Abs(distance-float64(radius)) <= 0.5
+	})
+}
+
+// circle blends the pixels around a center whose distance to it is accepted by inside.
+func (r *ImageRenderer) circle(
+	center raylib.Vector2,
+	radius float32,
+	color raylib.Color,
+	inside func(distance float64) bool,
+) {
+	bounds := r.clip(
+		float64(center.X-radius),
+		float64(center.Y-radius),
+		float64(center.X+radius),
+		float64(center.Y+radius),
+	)
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			distance := math.Hypot(float64(px)+0.5-float64(center.X), float64(py)+0.5-float64(center.Y))
+			if inside(distance) {
+				r.blend(px, py, color)
+			}
+		}
+	}
+}
+
+// clip returns the pixels whose centers may lie in a screen area, clipped to the image.
+func (r *ImageRenderer) clip(minX, minY, maxX, maxY float64) image.Rectangle {
+	return image.Rect(
+		int(math.Floor(minX)),
+		int(math.Floor(minY)),
+		int(math.Ceil(maxX)),
+		int(math.Ceil(maxY)),
+	).Intersect(r.frame.Bounds())
+}
+
+// blend draws a color over a pixel of the image, which stores premultiplied colors.
+func (r *ImageRenderer) blend(x, y int, color raylib.Color) {
+	if color.A == 0 || !(image.Point{X: x, Y: y}).In(r.frame.Bounds()) {
+		return
+	}
+	i := r.frame.PixOffset(x, y)
+	pixel := r.frame.Pix[i : i+4 : i+4]
+	alpha := uint32(color.A)
+	inverse := 255 - alpha
+	pixel[0] = uint8((uint32(color.R)*alpha + uint32(pixel[0])*inverse) / 255)
+	pixel[1] = uint8((uint32(color.G)*alpha + uint32(pixel[1])*inverse) / 255)
+	pixel[2] = uint8((uint32(color.B)*alpha + uint32(pixel[2])*inverse) / 255)
+	pixel[3] = uint8(alpha + uint32(pixel[3])*inverse/255)
+}
+
+// texture returns the decoded image of a texture, decoding it if needed.
+// Textures that cannot be decoded or are empty are logged once and skipped.
+func (r *ImageRenderer) texture(texture *Texture) *image.NRGBA {
+	if decoded, exists := r.textures[texture]; exists {
+		return decoded
+	}
+	var decoded *image.NRGBA
+	img, _, err := image.Decode(bytes.NewReader(texture.Data()))
+	if err != nil {
+		raylib.TraceLog(raylib.LogWarning, "image renderer: cannot decode texture: %v", err)
+	} else if img.Bounds().Empty() {
+		// Nothing to sample, and wrapping texel coordinates needs a non-zero size
+		raylib.TraceLog(raylib.LogWarning, "image renderer: texture is empty")
+	} else {
+		decoded = image.NewNRGBA(img.Bounds())
+		draw.Draw(decoded, decoded.Bounds(), img, img.Bounds().Min, draw.Src)
+	}
+	r.textures[texture] = decoded
+	return decoded
+}
+
+// wrap returns i modulo n, in [0, n). n must be positive.
+func wrap(i, n int) int {
+	i %= n
+	if i < 0 {
+		i += n
+	}
+	return i
+}
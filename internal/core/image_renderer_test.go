@@ -0,0 +1,126 @@
+package core
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"testing"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+// emptyImageMagic starts the data of images decoded with a zero size, which PNG doesn't allow.
+const emptyImageMagic = "empty image"
+
+func init() {
+	image.RegisterFormat("empty", emptyImageMagic,
+		func(io.Reader) (image.Image, error) { return image.NewNRGBA(image.Rect(0, 0, 0, 0)), nil },
+		func(io.Reader) (image.Config, error) { return image.Config{ColorModel: color.NRGBAModel}, nil },
+	)
+}
+
+// Texel colors of the test texture, one per quadrant
+var (
+	texelUpLeft    = color.RGBA{R: 255, A: 255}
+	texelUpRight   = color.RGBA{G: 255, A: 255}
+	texelDownLeft  = color.RGBA{B: 255, A: 255}
+	texelDownRight = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	background     = color.RGBA{}
+)
+
+// newQuadrantTexture returns a 2x2 texture with a different color per texel.
+func newQuadrantTexture(t *testing.T) *Texture {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, texelUpLeft)
+	img.Set(1, 0, texelUpRight)
+	img.Set(0, 1, texelDownLeft)
+	img.Set(1, 1, texelDownRight)
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		t.Fatal(err)
+	}
+	return NewTexture(data.Bytes())
+}
+
+// pixels maps screen pixels to their expected color.
+type pixels map[image.Point]color.RGBA
+
+func TestImageRendererDrawTexture(t *testing.T) {
+	tests := []struct {
+		name string
+		// draw draws a quadrant texture on an 8x8 screen
+		draw func(r *ImageRenderer, texture *Texture)
+		want pixels
+	}{
+		{"scaled", func(r *ImageRenderer, texture *Texture) {
+			r.DrawTexture(texture, raylib.NewRectangle(0, 0, 2, 2), raylib.NewRectangle(0, 0, 4, 4), raylib.Vector2{}, 0, raylib.White)
+		}, pixels{
+			{0, 0}: texelUpLeft, {1, 1}: texelUpLeft, {3, 0}: texelUpRight,
+			{0, 3}: texelDownLeft, {3, 3}: texelDownRight, {4, 4}: background,
+		}},
+		{"source rectangle", func(r *ImageRenderer, texture *Texture) {
+			r.DrawTexture(texture, raylib.NewRectangle(1, 0, 1, 1), raylib.NewRectangle(0, 0, 4, 4), raylib.Vector2{}, 0, raylib.White)
+		}, pixels{{0, 0}: texelUpRight, {3, 3}: texelUpRight, {4, 0}: background}},
+		{"source repeated past the edges", func(r *ImageRenderer, texture *Texture) {
+			r.DrawTexture(texture, raylib.NewRectangle(0, 0, 4, 2), raylib.NewRectangle(0, 0, 8, 4), raylib.Vector2{}, 0, raylib.White)
+		}, pixels{{0, 0}: texelUpLeft, {3, 0}: texelUpRight, {4, 0}: texelUpLeft, {7, 3}: texelDownRight}},
+		{"tint", func(r *ImageRenderer, texture *Texture) {
+			r.DrawTexture(texture, raylib.NewRectangle(0, 0, 2, 2), raylib.NewRectangle(0, 0, 2, 2), raylib.Vector2{}, 0, raylib.Red)
+		}, pixels{{0, 0}: {R: 230, A: 255}, {1, 0}: {G: 41, A: 255}, {1, 1}: {R: 230, G: 41, B: 55, A: 255}}},
+		{"rotated clockwise around the origin", func(r *ImageRenderer, texture *Texture) {
+			r.DrawTexture(texture, raylib.NewRectangle(0, 0, 2, 2), raylib.NewRectangle(4, 0, 4, 4), raylib.Vector2{}, 90, raylib.White)
+		}, pixels{
+			{3, 0}: texelUpLeft, {3, 3}: texelUpRight, {0, 0}: texelDownLeft, {0, 3}: texelDownRight,
+			{4, 0}: background,
+		}},
+		{"center pivot", func(r *ImageRenderer, texture *Texture) {
+			sprite := &Sprite{Texture: texture, Pivot: PivotCenter}
+			transform := NewTransform(4, 4)
+			transform.Scale = raylib.NewVector2(2, 2)
+			sprite.Draw(r, *transform)
+		}, pixels{
+			{2, 2}: texelUpLeft, {5, 2}: texelUpRight, {2, 5}: texelDownLeft, {5, 5}: texelDownRight,
+			{1, 1}: background, {6, 6}: background,
+		}},
+		{"down right pivot", func(r *ImageRenderer, texture *Texture) {
+			sprite := &Sprite{Texture: texture, Pivot: PivotDownRight}
+			sprite.Draw(r, *NewTransform(4, 4))
+		}, pixels{{2, 2}: texelUpLeft, {3, 3}: texelDownRight, {4, 4}: background}},
+		{"horizontal flip", func(r *ImageRenderer, texture *Texture) {
+			sprite := &Sprite{Texture: texture, Pivot: PivotUpLeft, FlipH: true}
+			sprite.Draw(r, *NewTransform(0, 0))
+		}, pixels{{0, 0}: texelUpRight, {1, 0}: texelUpLeft, {0, 1}: texelDownRight, {1, 1}: texelDownLeft}},
+		{"vertical flip", func(r *ImageRenderer, texture *Texture) {
+			sprite := &Sprite{Texture: texture, Pivot: PivotUpLeft, FlipV: true}
+			sprite.Draw(r, *NewTransform(0, 0))
+		}, pixels{{0, 0}: texelDownLeft, {1, 0}: texelDownRight, {0, 1}: texelUpLeft, {1, 1}: texelUpRight}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewImageRenderer(8, 8, 1.0/60)
+			r.BeginFrame()
+			tt.draw(r, newQuadrantTexture(t))
+			r.EndFrame()
+			for point, want := range tt.want {
+				if got := r.Image().RGBAAt(point.X, point.Y); got != want {
+					t.Errorf("pixel %v = %v, want %v", point, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestImageRendererSkipsEmptyTextures(t *testing.T) {
+	raylib.SetTraceLogLevel(raylib.LogError)
+	texture := NewTexture([]byte(emptyImageMagic))
+	r := NewImageRenderer(8, 8, 1.0/60)
+	r.BeginFrame()
+	r.DrawTexture(texture, raylib.NewRectangle(0, 0, 2, 2), raylib.NewRectangle(0, 0, 4, 4), raylib.Vector2{}, 0, raylib.White)
+	r.EndFrame()
+	if got := r.Image().RGBAAt(0, 0); got != background {
+		t.Errorf("pixel (0, 0) = %v, want %v", got, background)
+	}
+}
@@ -0,0 +1,242 @@
+package core
+
+import (
+	"maps"
+	"slices"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+// InputDevice identifies the device of an input binding.
+type InputDevice int
+
+const (
+	InputKey InputDevice = iota
+	InputMouseButton
+	InputGamepadButton
+	InputTouch
+)
+
+const (
+	Input_MaxGamepads = 4
+)
+
+// Named actions of the game, bound to inputs by default, see BindAction.
+const (
+	Action_Flap    = "flap"
+	Action_Pause   = "pause"
+	Action_Confirm = "confirm"
+)
+
+// Binding is an input that can trigger an action: a key, a mouse button,
+// a button of a gamepad or a tap on a touch screen.
+type Binding struct {
+	// Device is the kind of input.
+	Device InputDevice
+	// Gamepad is the index of the gamepad, for gamepad buttons.
+	Gamepad int32
+	// Code is the key or button, unused for touches.
+	Code int32
+}
+
+// KeyBinding returns the binding of a key.
+func KeyBinding(key int32) Binding {
+	return Binding{Device: InputKey, Code: key}
+}
+
+// MouseBinding returns the binding of a mouse button.
+func MouseBinding(button raylib.MouseButton) Binding {
+	return Binding{Device: InputMouseButton, Code: int32(button)}
+}
+
+// GamepadBinding returns the binding of a button of a gamepad.
+func GamepadBinding(gamepad, button int32) Binding {
+	return Binding{Device: InputGamepadButton, Gamepad: gamepad, Code: button}
+}
+
+// TouchBinding returns the binding of a tap on a touch screen.
+func TouchBinding() Binding {
+	return Binding{Device: InputTouch}
+}
+
+// inputState holds the bindings and actions pressed.
+type inputState struct {
+	bindings map[Binding]bool
+	actions  map[string]bool
+	// last is the latest binding pressed, if lastSet
+	last    Binding
+	lastSet bool
+}
+
+// pendingInput holds the presses simulated for the next frame,
+// currentInput the ones seen by the next update.
+var (
+	pendingInput = newInputState()
+	currentInput = newInputState()
+)
+
+// actionBindings maps every action to the bindings triggering it.
+var actionBindings = defaultActionBindings()
+
+func newInputState() inputState {
+	return inputState{bindings: map[Binding]bool{}, actions: map[string]bool{}}
+}
+
+// press records a pressed binding.
+func (s *inputState) press(binding Binding) {
+	s.bindings[binding] = true
+	s.last = binding
+	s.lastSet = true
+}
+
+// consume forgets every press.
+func (s *inputState) consume() {
+	clear(s.bindings)
+	clear(s.actions)
+	s.lastSet = false
+}
+
+// defaultActionBindings returns the bindings of the game actions:
+// flap and confirm on space, the left mouse button, the bottom face button of
+// the first gamepad and taps, confirm also on enter, pause on P and the start button.
+func defaultActionBindings() map[string][]Binding {
+	return map[string][]Binding{
+		Action_Flap: {
+			KeyBinding(raylib.KeySpace),
+			MouseBinding(raylib.MouseButtonLeft),
+			GamepadBinding(0, raylib.GamepadButtonRightFaceDown),
+			TouchBinding(),
+		},
+		Action_Pause: {
+			KeyBinding(raylib.KeyP),
+			GamepadBinding(0, raylib.GamepadButtonMiddleRight),
+		},
+		Action_Confirm: {
+			KeyBinding(raylib.KeySpace),
+			KeyBinding(raylib.KeyEnter),
+			MouseBinding(raylib.MouseButtonLeft),
+			GamepadBinding(0, raylib.GamepadButtonRightFaceDown),
+			TouchBinding(),
+		},
+	}
+}
+
+// BindAction replaces the bindings of an action, no bindings unbinds it.
+// Actions can be rebound at any time, e.g. to the input returned by LastPressed.
+func BindAction(action string, bindings ...Binding) {
+	if len(bindings) == 0 {
+		delete(actionBindings, action)
+		return
+	}
+	actionBindings[action] = slices.Clone(bindings)
+}
+
+// ActionBindings returns a copy of the bindings of an action.
+func ActionBindings(action string) []Binding {
+	return slices.Clone(actionBindings[action])
+}
+
+// Actions returns the names of the bound actions, sorted.
+func Actions() []string {
+	return slices.Sorted(maps.Keys(actionBindings))
+}
+
+// ResetActions restores the default bindings of every action.
+func ResetActions() {
+	actionBindings = defaultActionBindings()
+}
+
+// IsActionPressed reports whether an action was pressed, through any of its
+// bindings or simulated, since the previous update.
+func IsActionPressed(action string) bool {
+	if currentInput.actions[action] {
+		return true
+	}
+	for _, binding := range actionBindings[action] {
+		if currentInput.bindings[binding] {
+			return true
+		}
+	}
+	return false
+}
+
+// IsKeyPressed reports whether a key was pressed, for real or simulated,
+// since the previous update.
+func IsKeyPressed(key int32) bool {
+	return currentInput.bindings[KeyBinding(key)]
+}
+
+// IsMouseButtonPressed reports whether a mouse button was pressed, for real or
+// simulated, since the previous update.
+func IsMouseButtonPressed(button raylib.MouseButton) bool {
+	return currentInput.bindings[MouseBinding(button)]
+}
+
+// LastPressed returns the latest binding pressed since the previous update,
+// so an action can be rebound to the next input of the player.
+func LastPressed() (Binding, bool) {
+	return currentInput.last, currentInput.lastSet
+}
+
+// PressAction simulates an action press seen during the next frame,
+// whatever its bindings.
+func PressAction(action string) {
+	pendingInput.actions[action] = true
+}
+
+// Press simulates a binding press seen during the next frame,
+// so scripted sessions can drive the game without a keyboard.
+func Press(binding Binding) {
+	pendingInput.press(binding)
+}
+
+// PressKey simulates a key press seen during the next frame.
+func PressKey(key int32) {
+	Press(KeyBinding(key))
+}
+
+// PressMouseButton simulates a mouse button press seen during the next frame.
+func PressMouseButton(button raylib.MouseButton) {
+	Press(MouseBinding(button))
+}
+
+// pollInput starts a new frame, collecting the presses of the window devices
+// and the pending simulated ones. Presses are kept until an update consumes
+// them, so a frame running no update does not lose them.
+func pollInput() {
+	maps.Copy(currentInput.bindings, pendingInput.bindings)
+	maps.Copy(currentInput.actions, pendingInput.actions)
+	if pendingInput.lastSet {
+		currentInput.last = pendingInput.last
+		currentInput.lastSet = true
+	}
+	pendingInput.consume()
+
+	for key := raylib.GetKeyPressed(); key != raylib.KeyNull; key = raylib.GetKeyPressed() {
+		currentInput.press(KeyBinding(key))
+	}
+	for button := raylib.MouseButtonLeft; button <= raylib.MouseButtonBack; button++ {
+		if raylib.IsMouseButtonPressed(button) {
+			currentInput.press(MouseBinding(button))
+		}
+	}
+	for gamepad := int32(0); gamepad < Input_MaxGamepads; gamepad++ {
+		if !raylib.IsGamepadAvailable(gamepad) {
+			continue
+		}
+		for button := int32(raylib.GamepadButtonLeftFaceUp); button <= raylib.GamepadButtonRightThumb; button++ {
+			if raylib.IsGamepadButtonPressed(gamepad, button) {
+				currentInput.press(GamepadBinding(gamepad, button))
+			}
+		}
+	}
+	if raylib.IsGestureDetected(raylib.GestureTap) {
+		currentInput.press(TouchBinding())
+	}
+}
+
+// consumeInput forgets the presses seen by an update, so a frame running
+// several updates does not handle them twice.
+func consumeInput() {
+	currentInput.consume()
+}
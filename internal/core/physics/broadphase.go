@@ -0,0 +1,135 @@
+package physics
+
+import (
+	"sort"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AABB type
+type AABB struct {
+	// Top-left corner of the box
+	Min rl.Vector2
+	// Bottom-right corner of the box
+	Max rl.Vector2
+}
+
+// Overlaps - Checks if two boxes overlap (touching edges count as overlap)
+func (a AABB) Overlaps(b AABB) bool {
+	return a.Min.X <= b.Max.X && b.Min.X <= a.Max.X &&
+		a.Min.Y <= b.Max.Y && b.Min.Y <= a.Max.Y
+}
+
+// proxy - Broadphase entry of a body with its cached box and index in the bodies slice
+type proxy struct {
+	index int
+	box   AABB
+}
+
+// bodyPair - Candidate pair of bodies whose boxes overlap, stored as indices in the bodies slice
+type bodyPair struct {
+	a, b int
+}
+
+// GetAABB - Returns the world space axis aligned bounding box of the body shape
+func (b *Body) GetAABB() AABB {
+	switch b.Shape.Type {
+	case CircleShape:
+		r := b.Shape.Radius
+		return AABB{
+			Min: rl.NewVector2(b.Position.X-r, b.Position.Y-r),
+			Max: rl.NewVector2(b.Position.X+r, b.Position.Y+r),
+		}
+	default:
+		box := AABB{Min: b.Position, Max: b.Position}
+		for i := 0; i < b.Shape.VertexData.VertexCount; i++ {
+			v := b.GetShapeVertex(i)
+			box.Min.X = min(box.Min.X, v.X)
+			box.Min.Y = min(box.Min.Y, v.Y)
+			box.Max.X = max(box.Max.X, v.X)
+			box.Max.Y = max(box.Max.Y, v.Y)
+		}
+		return box
+	}
+}
+
+// SetBroadphase - Enables or disables the broadphase culling. When disabled every pair of
+// bodies is tested, which is only useful for debugging and benchmarking
+func (w *World) SetBroadphase(enabled bool) {
+	w.disableBroadphase = !enabled
+}
+
+// findPairs - Returns the pairs of bodies that may collide during this step, ordered by the
+// index of their bodies so the narrowphase runs in the same order as a brute force pass
+func (w *World) findPairs() []bodyPair {
+	w.pairs = w.pairs[:0]
+
+	if w.disableBroadphase {
+		for i := 0; i < len(w.bodies); i++ {
+			for j := i + 1; j < len(w.bodies); j++ {
+				if canCollide(w.bodies[i], w.bodies[j]) {
+					w.pairs = append(w.pairs, bodyPair{a: i, b: j})
+				}
+			}
+		}
+		return w.pairs
+	}
+
+	// Build the proxies of the bodies taking part in the step
+	w.proxies = w.proxies[:0]
+	for i, body := range w.bodies {
+		if body == nil || body.Paused || body.destroyed {
+			continue
+		}
+		w.proxies = append(w.proxies, proxy{index: i, box: body.GetAABB()})
+	}
+
+	// Sweep and prune along the X axis
+	sort.Slice(w.proxies, func(i, j int) bool {
+		return w.proxies[i].box.Min.X < w.proxies[j].box.Min.X
+	})
+	for i := 0; i < len(w.proxies); i++ {
+		proxyA := w.proxies[i]
+		for j := i + 1; j < len(w.proxies); j++ {
+			proxyB := w.proxies[j]
+			if proxyB.box.Min.X > proxyA.box.Max.X {
+				break
+			}
+			if !proxyA.box.Overlaps(proxyB.box) {
+				continue
+			}
+			a, b := proxyA.index, proxyB.index
+			if a > b {
+				a, b = b, a
+			}
+			if canCollide(w.bodies[a], w.bodies[b]) {
+				w.pairs = append(w.pairs, bodyPair{a: a, b: b})
+			}
+		}
+	}
+
+	sort.Slice(w.pairs, func(i, j int) bool {
+		if w.pairs[i].a != w.pairs[j].a {
+			return w.pairs[i].a < w.pairs[j].a
+		}
+		return w.pairs[i].b < w.pairs[j].b
+	})
+	return w.pairs
+}
+
+// canCollide - Checks if two bodies should reach the narrowphase
+func canCollide(a *Body, b *Body) bool {
+	if a == nil || b == nil || a.Paused || b.Paused || a.destroyed || b.destroyed || !a.CollidesWith(b) {
+		return false
+	}
+	// Nothing moves between static or sleeping bodies
+	if !a.isAwake() && !b.isAwake() {
+		return false
+	}
+	// Triggers only report overlaps, so they detect static and kinematic bodies too
+	if a.IsTrigger || b.IsTrigger {
+		return true
+	}
+	// Two static or kinematic bodies can't push each other
+	return a.InverseMass != 0 || b.InverseMass != 0
+}
@@ -0,0 +1,71 @@
+package physics
+
+import (
+	"fmt"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Broadphase benchmark world layout
+const (
+	benchBodySize = 10
+	benchSpacing  = 40
+	benchColumns  = 25
+	benchSteps    = 10
+)
+
+// benchBodyCounts - Amounts of bodies of the benchmark worlds
+var benchBodyCounts = []int{100, 250, 500, 1000}
+
+func BenchmarkStepBroadphase(b *testing.B) {
+	benchmarkStep(b, true)
+}
+
+func BenchmarkStepBruteForce(b *testing.B) {
+	benchmarkStep(b, false)
+}
+
+// benchmarkStep - Runs physics steps in worlds with a static floor and a grid of bodies spread
+// across a wide level, like pipe gates scrolling off-screen, reporting the pairs tested per step
+func benchmarkStep(b *testing.B, broadphase bool) {
+	for _, count := range benchBodyCounts {
+		b.Run(fmt.Sprintf("bodies=%d", count), func(b *testing.B) {
+			w := newBenchWorld(b, count)
+			w.SetBroadphase(broadphase)
+			b.ResetTimer()
+			pairs := 0
+			for range b.N {
+				w.Step(benchSteps)
+				pairs += w.GetStats().PairsTested
+			}
+			b.ReportMetric(float64(pairs)/float64(b.N*benchSteps), "pairs/step")
+		})
+	}
+}
+
+// newBenchWorld - Creates a benchmark world holding count bodies, half of them dynamic
+func newBenchWorld(b *testing.B, count int) *World {
+	w := NewWorld()
+	w.SetGravity(0, 800)
+	// Resting bodies would fall asleep sooner in the faster runs, skewing the comparison
+	w.SetSleeping(false)
+	rows := (count + benchColumns - 1) / benchColumns
+	floorWidth := float32(benchColumns * benchSpacing)
+	floorY := float32(rows*benchSpacing + benchSpacing)
+	if _, err := w.NewBodyRectangle("floor", rl.NewVector2(floorWidth/2, floorY), floorWidth, benchBodySize, 0); err != nil {
+		b.Fatal(err)
+	}
+	for i := range count {
+		x := float32(i%benchColumns*benchSpacing) + benchSpacing/2
+		y := float32(i/benchColumns*benchSpacing) + benchSpacing/2
+		density := float32(0)
+		if i%2 == 0 {
+			density = 1
+		}
+		if _, err := w.NewBodyRectangle("body", rl.NewVector2(x, y), benchBodySize, benchBodySize, density); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return w
+}
@@ -0,0 +1,158 @@
+package physics
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Continuous collision constants
+const (
+	// Maximum amount of positions sampled along a sweep
+	maxSweepSamples = 64
+	// Bisection iterations used to refine the time of impact
+	timeOfImpactIterations = 8
+)
+
+// sweepBodies - Sweeps every continuous collision body from its position at the beginning
+// of the step to its integrated position. Dynamic bodies are stopped at the time of impact
+// against the first solid body crossed, and crossed triggers are remembered so their contact
+// events are raised on the next step even if the body already went through them
+func (w *World) sweepBodies() {
+	for _, body := range w.bodies {
+		if !body.ContinuousCollision || body.Paused || body.destroyed || body.Type == StaticBody {
+			continue
+		}
+		w.sweepBody(body)
+	}
+}
+
+// sweepBody - Sweeps a single body, see sweepBodies. Each other body is swept against in its
+// own frame, so a fast body moving past a slow continuous collision body is not missed either
+func (w *World) sweepBody(body *Body) {
+	end := body.Position
+	for _, other := range w.bodies {
+		if other == body || !canCollide(body, other) {
+			continue
+		}
+
+		// Motion relative to the other body, ending at both bodies final positions
+		body.Position = end
+		start := rl.Vector2Add(body.sweepStart, sweepDisplacement(other))
+		displacement := rl.Vector2Subtract(end, start)
+		distance := rl.Vector2Length(displacement)
+
+		// Slow motions can't skip anything thicker than a point, the discrete detection is enough
+		box := body.GetAABB()
+		extent := min(box.Max.X-box.Min.X, box.Max.Y-box.Min.Y) / 2
+		if extent <= epsilon || distance <= extent {
+			continue
+		}
+
+		// Swept box covering the body at both ends of the motion
+		swept := AABB{
+			Min: rl.NewVector2(box.Min.X+min(0, -displacement.X), box.Min.Y+min(0, -displacement.Y)),
+			Max: rl.NewVector2(box.Max.X+max(0, -displacement.X), box.Max.Y+max(0, -displacement.Y)),
+		}
+		if !swept.Overlaps(other.GetAABB()) {
+			continue
+		}
+
+		// Bodies already touching at the beginning are handled by the discrete solver
+		if _, touching := touchingAt(body, other, start); touching {
+			continue
+		}
+
+		samples := min(int(math.Ceil(float64(distance/extent))), maxSweepSamples)
+		previous := float32(0)
+		for i := 1; i <= samples; i++ {
+			fraction := float32(i) / float32(samples)
+			manifold, touching := touchingAt(body, other, lerp(start, end, fraction))
+			if !touching {
+				previous = fraction
+				continue
+			}
+
+			// One-way bodies crossed from the wrong side are left to the discrete solver
+			if !oneWayAccepts(&manifold) {
+				break
+			}
+
+			if body.IsTrigger || other.IsTrigger {
+				w.sweptContacts = append(w.sweptContacts, manifold)
+				break
+			}
+
+			if body.Type == DynamicBody {
+				// Refine the time of impact and stop the body there against the other body
+				// final position, the solver resolves the remaining contact on the next step
+				impact := refineTimeOfImpact(body, other, start, end, previous, fraction)
+				end = lerp(start, end, impact)
+			}
+			break
+		}
+	}
+
+	// Leave the body at its final position, touchingAt moves it around while sampling
+	body.Position = end
+}
+
+// sweepDisplacement - Returns the displacement of a body during the current step
+func sweepDisplacement(body *Body) rl.Vector2 {
+	if body.Paused || body.destroyed || body.Type == StaticBody {
+		return rl.Vector2{}
+	}
+	return rl.Vector2Subtract(body.Position, body.sweepStart)
+}
+
+// addSweptContacts - Adds the trigger contacts found by the previous sweeps that the discrete
+// detection missed, so they raise their contact events
+func (w *World) addSweptContacts() {
+	for i := range w.sweptContacts {
+		swept := &w.sweptContacts[i]
+		if swept.BodyA.IsDestroyed() || swept.BodyB.IsDestroyed() {
+			continue
+		}
+		if _, exists := w.nextContactIndex[newContactKey(swept.BodyA, swept.BodyB)]; exists {
+			continue
+		}
+		manifold := w.createManifold(swept.BodyA, swept.BodyB)
+		manifold.Penetration = swept.Penetration
+		manifold.Normal = swept.Normal
+		manifold.Contacts = swept.Contacts
+		manifold.ContactsCount = swept.ContactsCount
+		w.addContact(manifold)
+	}
+	clear(w.sweptContacts)
+	w.sweptContacts = w.sweptContacts[:0]
+}
+
+// refineTimeOfImpact - Bisects the fraction of the motion where a body starts touching another,
+// between a free fraction and a touching one, returning a touching fraction
+func refineTimeOfImpact(body *Body, other *Body, start, end rl.Vector2, free, touching float32) float32 {
+	for i := 0; i < timeOfImpactIterations; i++ {
+		middle := (free + touching) / 2
+		if _, hit := touchingAt(body, other, lerp(start, end, middle)); hit {
+			touching = middle
+		} else {
+			free = middle
+		}
+	}
+	return touching
+}
+
+// touchingAt - Moves a body to a position and checks if it touches another body there
+func touchingAt(body *Body, other *Body, position rl.Vector2) (Manifold, bool) {
+	body.Position = position
+	manifold := Manifold{BodyA: body, BodyB: other}
+	solveShapes(&manifold)
+	return manifold, manifold.ContactsCount > 0
+}
+
+// lerp - Linearly interpolates between two vectors
+func lerp(from, to rl.Vector2, fraction float32) rl.Vector2 {
+	return rl.NewVector2(
+		from.X+(to.X-from.X)*fraction,
+		from.Y+(to.Y-from.Y)*fraction,
+	)
+}
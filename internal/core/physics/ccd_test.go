@@ -0,0 +1,87 @@
+package physics
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestFastTriggerPassingSlowContinuousBody(t *testing.T) {
+	w := NewWorld()
+	w.SetGravity(0, 0)
+	body, err := w.NewBodyCircle("body", rl.NewVector2(300, 300), 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.ContinuousCollision = true
+	trigger, err := w.NewTriggerRectangle("trigger", rl.NewVector2(200, 300), 4, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Much more than the body size and the trigger width in a single step
+	trigger.Velocity.X = 60 / w.GetTimeStep()
+
+	enters := 0
+	body.OnEnter = func(other *Body, manifold *Manifold) {
+		if other == trigger {
+			enters++
+		}
+	}
+	w.Step(10)
+
+	if trigger.Position.X < 400 {
+		t.Fatalf("trigger did not pass the body, at %v", trigger.Position)
+	}
+	if enters != 1 {
+		t.Errorf("OnEnter raised %d times, want 1", enters)
+	}
+}
+
+func TestFastBodyDoesNotTunnelThroughThinBody(t *testing.T) {
+	walls := []struct {
+		name    string
+		newWall func(w *World) (*Body, error)
+	}{
+		{"static", func(w *World) (*Body, error) {
+			return w.NewBodyRectangle("wall", rl.NewVector2(300, 300), 4, 200, 0)
+		}},
+		{"kinematic", func(w *World) (*Body, error) {
+			return w.NewKinematicRectangle("wall", rl.NewVector2(300, 300), 4, 200)
+		}},
+	}
+	for _, wall := range walls {
+		for _, continuous := range []bool{true, false} {
+			name := wall.name + " without continuous collision"
+			if continuous {
+				name = wall.name + " with continuous collision"
+			}
+			t.Run(name, func(t *testing.T) {
+				w := NewWorld()
+				w.SetGravity(0, 0)
+				thin, err := wall.newWall(w)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, err := w.NewBodyCircle("body", rl.NewVector2(200, 300), 5, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body.ContinuousCollision = continuous
+				// Much more than the body size and the wall width in a single step
+				body.Velocity.X = 60 / w.GetTimeStep()
+				hits := 0
+				body.OnEnter = func(other *Body, manifold *Manifold) { hits++ }
+				w.Step(10)
+
+				tunnelled := body.Position.X > thin.Position.X
+				if continuous && (tunnelled || hits != 1) {
+					t.Errorf("body hit the wall %d times and tunnelled %v, at %v", hits, tunnelled, body.Position)
+				}
+				// Without it the discrete detection misses the wall, showing what the sweep is for
+				if !continuous && !tunnelled {
+					t.Errorf("body was stopped without continuous collision, at %v", body.Position)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package physics
+
+// contactKey - Identifies a pair of touching bodies regardless of their order
+type contactKey struct {
+	a, b int
+}
+
+// contact - Pair of bodies touching during a step
+type contact struct {
+	key      contactKey
+	bodyA    *Body
+	bodyB    *Body
+	manifold *Manifold
+}
+
+// newContactKey - Returns the key of the pair formed by two bodies
+func newContactKey(a *Body, b *Body) contactKey {
+	if a.ID > b.ID {
+		a, b = b, a
+	}
+	return contactKey{a: a.ID, b: b.ID}
+}
+
+// addContact - Records a touching pair found during the current step
+func (w *World) addContact(manifold *Manifold) {
+	key := newContactKey(manifold.BodyA, manifold.BodyB)
+	if _, exists := w.nextContactIndex[key]; exists {
+		return
+	}
+	w.nextContactIndex[key] = struct{}{}
+	w.nextContacts = append(w.nextContacts, contact{
+		key:      key,
+		bodyA:    manifold.BodyA,
+		bodyB:    manifold.BodyB,
+		manifold: manifold,
+	})
+}
+
+// dispatchContacts - Raises enter and stay events for the current step contacts and exit
+// events for the previous step contacts that are gone, then makes the current contacts the
+// previous ones. Contacts involving a paused or sleeping body are kept without raising any event
+func (w *World) dispatchContacts() {
+	for _, c := range w.nextContacts {
+		if _, existed := w.contactIndex[c.key]; existed {
+			notifyStay(c.bodyA, c.bodyB, c.manifold)
+			notifyStay(c.bodyB, c.bodyA, c.manifold)
+		} else {
+			notifyEnter(c.bodyA, c.bodyB, c.manifold)
+			notifyEnter(c.bodyB, c.bodyA, c.manifold)
+		}
+	}
+
+	for _, c := range w.contacts {
+		if _, exists := w.nextContactIndex[c.key]; exists {
+			continue
+		}
+		if !c.bodyA.IsDestroyed() && !c.bodyB.IsDestroyed() &&
+			(c.bodyA.Paused || c.bodyB.Paused || c.bodyA.IsSleeping || c.bodyB.IsSleeping) {
+			// Frozen contact, carry it over untouched
+			c.manifold = nil
+			w.nextContactIndex[c.key] = struct{}{}
+			w.nextContacts = append(w.nextContacts, c)
+			continue
+		}
+		notifyExit(c.bodyA, c.bodyB)
+		notifyExit(c.bodyB, c.bodyA)
+	}
+
+	// Swap the buffers so the current step contacts become the previous ones
+	clear(w.contacts)
+	w.contacts, w.nextContacts = w.nextContacts, w.contacts[:0]
+	w.contactIndex, w.nextContactIndex = w.nextContactIndex, w.contactIndex
+	clear(w.nextContactIndex)
+}
+
+// clearContacts - Forgets every tracked contact without raising events
+func (w *World) clearContacts() {
+	clear(w.contacts)
+	w.contacts = w.contacts[:0]
+	clear(w.nextContacts)
+	w.nextContacts = w.nextContacts[:0]
+	clear(w.contactIndex)
+	clear(w.nextContactIndex)
+}
+
+// notifyEnter - Calls the enter callback of a body that is not destroyed
+func notifyEnter(body *Body, other *Body, manifold *Manifold) {
+	if body.OnEnter != nil && !body.IsDestroyed() {
+		body.OnEnter(other, manifold)
+	}
+}
+
+// notifyStay - Calls the stay callback of a body that is not destroyed
+func notifyStay(body *Body, other *Body, manifold *Manifold) {
+	if body.OnStay != nil && !body.IsDestroyed() {
+		body.OnStay(other, manifold)
+	}
+}
+
+// notifyExit - Calls the exit callback of a body that is not destroyed
+func notifyExit(body *Body, other *Body) {
+	if body.OnExit != nil && !body.IsDestroyed() {
+		body.OnExit(other)
+	}
+}
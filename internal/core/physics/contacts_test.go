@@ -0,0 +1,58 @@
+package physics
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestContactEventsFireOnce(t *testing.T) {
+	tests := []struct {
+		name string
+		// newOther creates the body the ball falls through or onto
+		newOther func(w *World) (*Body, error)
+		// Expected exit events, a ball resting on a floor never leaves it
+		exits int
+	}{
+		{"trigger", func(w *World) (*Body, error) {
+			return w.NewTriggerRectangle("trigger", rl.NewVector2(100, 200), 200, 20)
+		}, 1},
+		{"floor", func(w *World) (*Body, error) {
+			return w.NewBodyRectangle("floor", rl.NewVector2(100, 200), 200, 20, 0)
+		}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld()
+			w.SetGravity(0, 800)
+			ball, err := w.NewBodyCircle("ball", rl.NewVector2(100, 100), 10, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			other, err := tt.newOther(w)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var enters, stays, exits [2]int
+			for i, body := range []*Body{ball, other} {
+				body.OnEnter = func(other *Body, manifold *Manifold) { enters[i]++ }
+				body.OnStay = func(other *Body, manifold *Manifold) { stays[i]++ }
+				body.OnExit = func(other *Body) { exits[i]++ }
+			}
+			stepSeconds(w, 2)
+
+			for i, name := range []string{"ball", tt.name} {
+				if enters[i] != 1 {
+					t.Errorf("%s OnEnter raised %d times, want 1", name, enters[i])
+				}
+				if stays[i] == 0 {
+					t.Errorf("%s OnStay never raised", name)
+				}
+				if exits[i] != tt.exits {
+					t.Errorf("%s OnExit raised %d times, want %d", name, exits[i], tt.exits)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package physics
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Debug draw constants
+const (
+	// Length of the drawn contact normals, in pixels
+	debugNormalLength = 12.0
+	// Radius of the drawn contact points, in pixels
+	debugContactRadius = 2.0
+	// Font size of the statistics text
+	debugFontSize = 10
+	// Margin of the statistics text from the top left corner, in pixels
+	debugTextMargin = 8
+)
+
+// Debug draw colors
+var (
+	debugDynamicColor   = rl.Blue
+	debugStaticColor    = rl.DarkGreen
+	debugKinematicColor = rl.Purple
+	debugTriggerColor   = rl.Orange
+	debugPausedColor    = rl.Gray
+	debugSleepingColor  = rl.SkyBlue
+	debugContactColor   = rl.Red
+	debugNormalColor    = rl.Yellow
+	debugJointColor     = rl.Magenta
+	debugTextColor      = rl.Black
+)
+
+// DebugRenderer - Drawing primitives used by the debug overlay
+type DebugRenderer interface {
+	DrawLine(from, to rl.Vector2, color rl.Color)
+	DrawCircle(center rl.Vector2, radius float32, color rl.Color)
+	DrawCircleLines(center rl.Vector2, radius float32, color rl.Color)
+	DrawText(text string, x, y, fontSize int32, color rl.Color)
+}
+
+// DebugDraw - Draws the outline of every body, the contact points and normals of the last
+// step manifolds, the joints and the statistics of the last update with a renderer
+func (w *World) DebugDraw(r DebugRenderer) {
+	for _, body := range w.bodies {
+		if body == nil || body.destroyed {
+			continue
+		}
+		debugDrawBody(r, body)
+	}
+
+	for _, joint := range w.joints {
+		anchorA, anchorB := joint.AnchorA(), joint.AnchorB()
+		r.DrawLine(anchorA, anchorB, debugJointColor)
+		r.DrawCircleLines(anchorA, debugContactRadius, debugJointColor)
+		r.DrawCircleLines(anchorB, debugContactRadius, debugJointColor)
+	}
+
+	for _, manifold := range w.manifolds {
+		for i := 0; i < manifold.ContactsCount; i++ {
+			contact := manifold.Contacts[i]
+			r.DrawCircle(contact, debugContactRadius, debugContactColor)
+			r.DrawLine(
+				contact,
+				rl.Vector2Add(contact, rl.Vector2Scale(manifold.Normal, debugNormalLength)),
+				debugNormalColor,
+			)
+		}
+	}
+
+	debugDrawStats(r, w.stats)
+}
+
+// debugDrawStats - Draws the statistics of the last update in the top left corner
+func debugDrawStats(r DebugRenderer, stats Stats) {
+	lines := []string{
+		fmt.Sprintf("steps: %d  step: %v  solver: %v", stats.Steps, stats.StepTime, stats.SolverTime),
+		fmt.Sprintf(
+			"bodies: %d  active: %d  paused: %d  sleeping: %d",
+			stats.Bodies, stats.ActiveBodies, stats.PausedBodies, stats.SleepingBodies,
+		),
+		fmt.Sprintf(
+			"pairs: %d  manifolds: %d  contacts: %d",
+			stats.PairsTested, stats.ManifoldsCreated, stats.ContactManifolds,
+		),
+	}
+	for i, line := range lines {
+		r.DrawText(line, debugTextMargin, debugTextMargin+int32(i)*(debugFontSize+2), debugFontSize, debugTextColor)
+	}
+}
+
+// debugDrawBody - Draws the shape outline of a body and a line showing its orientation
+func debugDrawBody(r DebugRenderer, body *Body) {
+	color := debugColor(body)
+
+	count := 0
+	switch body.Shape.Type {
+	case CircleShape:
+		count = circleVertices
+	case PolygonShape:
+		count = body.Shape.VertexData.VertexCount
+	}
+	for i := 0; i < count; i++ {
+		r.DrawLine(body.GetShapeVertex(i), body.GetShapeVertex((i+1)%count), color)
+	}
+
+	if body.Shape.Type == CircleShape {
+		r.DrawLine(body.Position, body.GetShapeVertex(0), color)
+	}
+}
+
+// debugColor - Returns the outline color of a body, the paused and sleeping states take
+// precedence over the trigger state, which takes precedence over the body type. Disabled
+// bodies don't move and are drawn as static
+func debugColor(body *Body) rl.Color {
+	switch {
+	case body.Paused:
+		return debugPausedColor
+	case body.IsSleeping:
+		return debugSleepingColor
+	case body.IsTrigger:
+		return debugTriggerColor
+	case body.Type == StaticBody || !body.Enabled:
+		return debugStaticColor
+	case body.Type == KinematicBody:
+		return debugKinematicColor
+	default:
+		return debugDynamicColor
+	}
+}
@@ -0,0 +1,38 @@
+package physics
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// GetInterpolationAlpha - Returns how far the accumulated time is between the last step and
+// the next one (0 to 1), to render bodies between their previous and current transforms
+func (w *World) GetInterpolationAlpha() float32 {
+	if w == nil || w.deltaTime <= 0 {
+		return 1
+	}
+	return rl.Clamp(w.accumulator/w.deltaTime, 0, 1)
+}
+
+// InterpolatedPosition - Returns the body position between the previous step and the last one
+func (b *Body) InterpolatedPosition(alpha float32) rl.Vector2 {
+	return rl.Vector2Lerp(b.PreviousPosition, b.Position, alpha)
+}
+
+// InterpolatedOrient - Returns the body rotation between the previous step and the last one
+func (b *Body) InterpolatedOrient(alpha float32) float32 {
+	return b.PreviousOrient + (b.Orient-b.PreviousOrient)*alpha
+}
+
+// ResetInterpolation - Makes the previous transform the current one, so a body moved by hand
+// is rendered at its new position right away instead of sliding there
+func (b *Body) ResetInterpolation() {
+	b.PreviousPosition = b.Position
+	b.PreviousOrient = b.Orient
+}
+
+// storePreviousTransforms - Keeps the transform of every body before it is stepped
+func (w *World) storePreviousTransforms() {
+	for _, body := range w.bodies {
+		body.ResetInterpolation()
+	}
+}
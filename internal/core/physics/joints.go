@@ -0,0 +1,366 @@
+package physics
+
+import (
+	"errors"
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// JointType type
+type JointType int
+
+// Physics joint types
+const (
+	// Keeps the anchors at a fixed distance
+	DistanceJoint JointType = iota
+	// Pins both anchors together, letting the bodies rotate around the pin
+	RevoluteJoint
+	// Pulls the anchors towards a rest length with a damped spring force
+	SpringJoint
+)
+
+// Joint constants
+const (
+	// Fraction of the joint position error fixed every step
+	jointBaumgarte = 0.2
+)
+
+// ErrInvalidJoint is returned when the joint bodies can't be linked together
+var ErrInvalidJoint = errors.New("physics: invalid joint")
+
+// Joint type
+type Joint struct {
+	// Reference unique identifier
+	ID int
+	// Physics joint type (distance, revolute or spring)
+	Type JointType
+	// Enabled state, disabled joints are not solved
+	Enabled bool
+	// Joint first physics body reference
+	BodyA *Body
+	// Joint second physics body reference, nil to attach the joint to a fixed world point
+	BodyB *Body
+	// Anchor on the first body, relative to its position and unrotated
+	LocalAnchorA rl.Vector2
+	// Anchor on the second body, relative to its position and unrotated, or the world
+	// point when there is no second body
+	LocalAnchorB rl.Vector2
+	// Rest length of distance and spring joints
+	Length float32
+	// Spring force per unit of stretch
+	Stiffness float32
+	// Spring force per unit of relative velocity along the spring
+	Damping float32
+	// Physics world the joint belongs to
+	world *World
+}
+
+// NewDistanceJoint - Creates a joint keeping two world space anchors at their current distance.
+// When bodyB is nil, anchorB is a fixed world point
+func (w *World) NewDistanceJoint(bodyA, bodyB *Body, anchorA, anchorB rl.Vector2) (*Joint, error) {
+	joint, err := w.newJoint(DistanceJoint, bodyA, bodyB, anchorA, anchorB)
+	if err != nil {
+		return nil, err
+	}
+	joint.Length = rl.Vector2Distance(anchorA, anchorB)
+	return joint, nil
+}
+
+// NewRevoluteJoint - Creates a joint pinning two bodies together at a world space anchor.
+// When bodyB is nil, the first body is pinned to the world
+func (w *World) NewRevoluteJoint(bodyA, bodyB *Body, anchor rl.Vector2) (*Joint, error) {
+	return w.newJoint(RevoluteJoint, bodyA, bodyB, anchor, anchor)
+}
+
+// NewSpringJoint - Creates a damped spring between two world space anchors, resting at their
+// current distance. When bodyB is nil, anchorB is a fixed world point
+func (w *World) NewSpringJoint(
+	bodyA, bodyB *Body,
+	anchorA, anchorB rl.Vector2,
+	stiffness, damping float32,
+) (*Joint, error) {
+	if stiffness < 0 || damping < 0 {
+		return nil, ErrInvalidJoint
+	}
+	joint, err := w.newJoint(SpringJoint, bodyA, bodyB, anchorA, anchorB)
+	if err != nil {
+		return nil, err
+	}
+	joint.Length = rl.Vector2Distance(anchorA, anchorB)
+	joint.Stiffness = stiffness
+	joint.Damping = damping
+	return joint, nil
+}
+
+// GetJoints - Returns the slice of created physics joints
+func (w *World) GetJoints() []*Joint {
+	return w.joints
+}
+
+// AnchorA - Returns the world space position of the first anchor
+func (j *Joint) AnchorA() rl.Vector2 {
+	return anchorPosition(j.BodyA, j.LocalAnchorA)
+}
+
+// AnchorB - Returns the world space position of the second anchor
+func (j *Joint) AnchorB() rl.Vector2 {
+	return anchorPosition(j.BodyB, j.LocalAnchorB)
+}
+
+// Destroy - Unitializes and destroy a physics joint
+func (j *Joint) Destroy() {
+	w := j.world
+	if w == nil {
+		return
+	}
+	for i, joint := range w.joints {
+		if joint == j {
+			copy(w.joints[i:], w.joints[i+1:])
+			w.joints[len(w.joints)-1] = nil
+			w.joints = w.joints[:len(w.joints)-1]
+			break
+		}
+	}
+	j.world = nil
+}
+
+// newJoint - Creates a joint with generic values between two bodies of the world
+func (w *World) newJoint(jointType JointType, bodyA, bodyB *Body, anchorA, anchorB rl.Vector2) (*Joint, error) {
+	if w == nil {
+		return nil, ErrNilWorld
+	}
+	if bodyA == nil || bodyA == bodyB || bodyA.world != w || (bodyB != nil && bodyB.world != w) {
+		return nil, ErrInvalidJoint
+	}
+
+	joint := &Joint{
+		ID:           w.nextJointID,
+		Type:         jointType,
+		Enabled:      true,
+		BodyA:        bodyA,
+		BodyB:        bodyB,
+		LocalAnchorA: localAnchor(bodyA, anchorA),
+		LocalAnchorB: localAnchor(bodyB, anchorB),
+		world:        w,
+	}
+	w.nextJointID++
+
+	// Add new joint to joints pointers slice
+	w.joints = append(w.joints, joint)
+	return joint, nil
+}
+
+// destroyBodyJoints - Destroys every joint attached to a body
+func (w *World) destroyBodyJoints(body *Body) {
+	for i := len(w.joints) - 1; i >= 0; i-- {
+		if joint := w.joints[i]; joint.BodyA == body || joint.BodyB == body {
+			joint.Destroy()
+		}
+	}
+}
+
+// clearJoints - Unitializes every physics joint
+func (w *World) clearJoints() {
+	for _, joint := range w.joints {
+		joint.world = nil
+	}
+	clear(w.joints)
+	w.joints = w.joints[:0]
+}
+
+// isActive - Checks if the joint takes part in the step
+func (j *Joint) isActive() bool {
+	if !j.Enabled || j.BodyA.Paused || j.BodyA.destroyed {
+		return false
+	}
+	return j.BodyB == nil || (!j.BodyB.Paused && !j.BodyB.destroyed)
+}
+
+// applySpringForces - Adds the spring joints forces to their bodies before integrating forces
+func (w *World) applySpringForces() {
+	for _, joint := range w.joints {
+		if joint.Type != SpringJoint || !joint.isActive() {
+			continue
+		}
+
+		anchorA, anchorB := joint.AnchorA(), joint.AnchorB()
+		delta := rl.Vector2Subtract(anchorB, anchorA)
+		length := rl.Vector2Length(delta)
+		if length <= epsilon {
+			continue
+		}
+		normal := rl.NewVector2(delta.X/length, delta.Y/length)
+
+		radiusA := rl.Vector2Subtract(anchorA, joint.BodyA.Position)
+		radiusB := rl.Vector2Subtract(anchorB, bodyPosition(joint.BodyB, anchorB))
+		relativeVelocity := rl.Vector2Subtract(
+			pointVelocity(joint.BodyB, radiusB),
+			pointVelocity(joint.BodyA, radiusA),
+		)
+
+		// Hooke's law plus damping along the spring direction
+		magnitude := joint.Stiffness*(length-joint.Length) +
+			joint.Damping*rl.Vector2DotProduct(relativeVelocity, normal)
+		force := rl.NewVector2(normal.X*magnitude, normal.Y*magnitude)
+
+		applyForceAt(joint.BodyA, force, radiusA)
+		applyForceAt(joint.BodyB, rl.NewVector2(-force.X, -force.Y), radiusB)
+	}
+}
+
+// solveJoints - Applies one iteration of the distance and revolute joints impulses
+func (w *World) solveJoints() {
+	for _, joint := range w.joints {
+		if !joint.isActive() {
+			continue
+		}
+		switch joint.Type {
+		case DistanceJoint:
+			w.solveDistanceJoint(joint)
+		case RevoluteJoint:
+			w.solveRevoluteJoint(joint)
+		}
+	}
+}
+
+// solveDistanceJoint - Applies an impulse along the joint axis to keep its length
+func (w *World) solveDistanceJoint(joint *Joint) {
+	bodyA, bodyB := joint.BodyA, joint.BodyB
+	anchorA, anchorB := joint.AnchorA(), joint.AnchorB()
+	delta := rl.Vector2Subtract(anchorB, anchorA)
+	length := rl.Vector2Length(delta)
+	if length <= epsilon {
+		return
+	}
+	normal := rl.NewVector2(delta.X/length, delta.Y/length)
+
+	radiusA := rl.Vector2Subtract(anchorA, bodyA.Position)
+	radiusB := rl.Vector2Subtract(anchorB, bodyPosition(bodyB, anchorB))
+	raCrossN := rl.Vector2CrossProduct(radiusA, normal)
+	rbCrossN := rl.Vector2CrossProduct(radiusB, normal)
+	inverseMassSum := inverseMass(bodyA) + inverseMass(bodyB) +
+		raCrossN*raCrossN*inverseInertia(bodyA) + rbCrossN*rbCrossN*inverseInertia(bodyB)
+	if inverseMassSum <= epsilon {
+		return
+	}
+
+	// Relative velocity along the axis plus a bias fixing the length error
+	relativeVelocity := rl.Vector2Subtract(pointVelocity(bodyB, radiusB), pointVelocity(bodyA, radiusA))
+	bias := jointBaumgarte / w.deltaTime * (length - joint.Length)
+	impulse := -(rl.Vector2DotProduct(relativeVelocity, normal) + bias) / inverseMassSum
+
+	impulseV := rl.NewVector2(normal.X*impulse, normal.Y*impulse)
+	applyImpulseAt(bodyA, rl.NewVector2(-impulseV.X, -impulseV.Y), radiusA)
+	applyImpulseAt(bodyB, impulseV, radiusB)
+}
+
+// solveRevoluteJoint - Applies an impulse bringing both anchors together
+func (w *World) solveRevoluteJoint(joint *Joint) {
+	bodyA, bodyB := joint.BodyA, joint.BodyB
+	anchorA, anchorB := joint.AnchorA(), joint.AnchorB()
+	radiusA := rl.Vector2Subtract(anchorA, bodyA.Position)
+	radiusB := rl.Vector2Subtract(anchorB, bodyPosition(bodyB, anchorB))
+
+	massA, massB := inverseMass(bodyA), inverseMass(bodyB)
+	inertiaA, inertiaB := inverseInertia(bodyA), inverseInertia(bodyB)
+
+	// Effective mass matrix of the point to point constraint
+	k11 := massA + massB + inertiaA*radiusA.Y*radiusA.Y + inertiaB*radiusB.Y*radiusB.Y
+	k12 := -inertiaA*radiusA.X*radiusA.Y - inertiaB*radiusB.X*radiusB.Y
+	k22 := massA + massB + inertiaA*radiusA.X*radiusA.X + inertiaB*radiusB.X*radiusB.X
+	determinant := k11*k22 - k12*k12
+	if math.Abs(float64(determinant)) <= epsilon {
+		return
+	}
+
+	// Relative velocity of the anchors plus a bias fixing their separation
+	relativeVelocity := rl.Vector2Subtract(pointVelocity(bodyB, radiusB), pointVelocity(bodyA, radiusA))
+	separation := rl.Vector2Subtract(anchorB, anchorA)
+	rhs := rl.NewVector2(
+		-(relativeVelocity.X + jointBaumgarte/w.deltaTime*separation.X),
+		-(relativeVelocity.Y + jointBaumgarte/w.deltaTime*separation.Y),
+	)
+	impulseV := rl.NewVector2(
+		(k22*rhs.X-k12*rhs.Y)/determinant,
+		(k11*rhs.Y-k12*rhs.X)/determinant,
+	)
+
+	applyImpulseAt(bodyA, rl.NewVector2(-impulseV.X, -impulseV.Y), radiusA)
+	applyImpulseAt(bodyB, impulseV, radiusB)
+}
+
+// localAnchor - Converts a world space anchor to the unrotated body space, or keeps it as a
+// world point when there is no body
+func localAnchor(body *Body, anchor rl.Vector2) rl.Vector2 {
+	if body == nil {
+		return anchor
+	}
+	return rl.Mat2MultiplyVector2(
+		rl.Mat2Transpose(rl.Mat2Radians(body.Orient)),
+		rl.Vector2Subtract(anchor, body.Position),
+	)
+}
+
+// anchorPosition - Converts a body space anchor to world space
+func anchorPosition(body *Body, anchor rl.Vector2) rl.Vector2 {
+	if body == nil {
+		return anchor
+	}
+	return rl.Vector2Add(body.Position, rl.Mat2MultiplyVector2(rl.Mat2Radians(body.Orient), anchor))
+}
+
+// bodyPosition - Returns the body position, or the fixed point itself when there is no body
+func bodyPosition(body *Body, point rl.Vector2) rl.Vector2 {
+	if body == nil {
+		return point
+	}
+	return body.Position
+}
+
+// pointVelocity - Returns the velocity of a point of a body at a radius from its position
+func pointVelocity(body *Body, radius rl.Vector2) rl.Vector2 {
+	if body == nil {
+		return rl.Vector2{}
+	}
+	return rl.Vector2Add(body.Velocity, rl.Vector2Cross(body.AngularVelocity, radius))
+}
+
+// inverseMass - Returns the inverse mass a joint sees for a body, zero for fixed points
+func inverseMass(body *Body) float32 {
+	if body == nil || !body.Enabled {
+		return 0
+	}
+	return body.InverseMass
+}
+
+// inverseInertia - Returns the inverse inertia a joint sees for a body, zero for fixed points
+// and frozen orientations
+func inverseInertia(body *Body) float32 {
+	if body == nil || !body.Enabled || body.FreezeOrient {
+		return 0
+	}
+	return body.InverseInertia
+}
+
+// applyImpulseAt - Applies an impulse to a body at a radius from its position
+func applyImpulseAt(body *Body, impulse rl.Vector2, radius rl.Vector2) {
+	if body == nil || !body.Enabled {
+		return
+	}
+	body.Velocity.X += body.InverseMass * impulse.X
+	body.Velocity.Y += body.InverseMass * impulse.Y
+	if !body.FreezeOrient {
+		body.AngularVelocity += body.InverseInertia * rl.Vector2CrossProduct(radius, impulse)
+	}
+}
+
+// applyForceAt - Adds a force to a body at a radius from its position. Unlike AddForce, it
+// does not wake the body up, so bodies resting on a spring can still fall asleep
+func applyForceAt(body *Body, force rl.Vector2, radius rl.Vector2) {
+	if body == nil {
+		return
+	}
+	body.Force = rl.Vector2Add(body.Force, force)
+	body.Torque += rl.Vector2CrossProduct(radius, force)
+}
@@ -0,0 +1,88 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestRectangleInertiaIsAroundCentroid(t *testing.T) {
+	w := NewWorld()
+	body, err := w.NewBodyRectangle("box", rl.NewVector2(400, 300), 20, 20, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := body.Mass * (20*20 + 20*20) / 12
+	if math.Abs(float64(body.Inertia-want)) > float64(want)*1e-3 {
+		t.Errorf("Inertia = %v, want %v", body.Inertia, want)
+	}
+}
+
+func TestRevolutePendulumPeriod(t *testing.T) {
+	const (
+		gravity = 800
+		length  = 50
+		angle   = 0.1
+	)
+	anchor := rl.NewVector2(400, 250)
+	position := rl.NewVector2(
+		anchor.X+length*float32(math.Sin(angle)),
+		anchor.Y+length*float32(math.Cos(angle)),
+	)
+	tests := []struct {
+		name string
+		// newBody creates the pendulum body and returns its inertia around its centroid
+		newBody func(w *World) (*Body, float32, error)
+	}{
+		{"rectangle", func(w *World) (*Body, float32, error) {
+			body, err := w.NewBodyRectangle("bob", position, 20, 20, 1)
+			if err != nil {
+				return nil, 0, err
+			}
+			return body, body.Mass * (20*20 + 20*20) / 12, nil
+		}},
+		{"circle", func(w *World) (*Body, float32, error) {
+			body, err := w.NewBodyCircle("bob", position, 10, 1)
+			if err != nil {
+				return nil, 0, err
+			}
+			return body, body.Mass * 10 * 10, nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld()
+			w.SetGravity(0, gravity)
+			body, inertia, err := tt.newBody(w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := w.NewRevoluteJoint(body, nil, anchor); err != nil {
+				t.Fatal(err)
+			}
+
+			// Physical pendulum: T = 2π √(I / (m g d)), with I around the pivot
+			pivotInertia := float64(inertia) + float64(body.Mass)*length*length
+			want := 2 * math.Pi * math.Sqrt(pivotInertia/(float64(body.Mass)*gravity*length))
+
+			// Time the pendulum crossing its rest position three times, one full period
+			var crossings []float64
+			previous := body.Position.X - anchor.X
+			for i := 1; len(crossings) < 3 && float64(i)*float64(w.GetTimeStep()) < 5*want; i++ {
+				w.Step(1)
+				current := body.Position.X - anchor.X
+				if (previous < 0) != (current < 0) {
+					crossings = append(crossings, float64(i)*float64(w.GetTimeStep()))
+				}
+				previous = current
+			}
+			if len(crossings) < 3 {
+				t.Fatalf("pendulum crossed its rest position %d times, want 3", len(crossings))
+			}
+			if got := crossings[2] - crossings[0]; math.Abs(got-want) > want*0.05 {
+				t.Errorf("period = %.3fs, want %.3fs", got, want)
+			}
+		})
+	}
+}
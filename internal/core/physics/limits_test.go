@@ -0,0 +1,98 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// newLimitsBody - Creates a world with a gravity and a single free circle
+func newLimitsBody(t *testing.T, gravity float32) (*World, *Body) {
+	t.Helper()
+	w := NewWorld()
+	w.SetGravity(0, gravity)
+	w.SetSleeping(false)
+	body, err := w.NewBodyCircle("body", rl.NewVector2(0, 0), 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w, body
+}
+
+// near - Reports whether a value is within a relative tolerance of another
+func near(got, want, tolerance float32) bool {
+	return math.Abs(float64(got-want)) <= math.Abs(float64(want*tolerance))+1e-3
+}
+
+func TestGravityScale(t *testing.T) {
+	for _, scale := range []float32{0, 0.5, 1, 2} {
+		w, body := newLimitsBody(t, 100)
+		body.GravityScale = scale
+		stepSeconds(w, 1)
+		if want := 100 * scale; !near(body.Velocity.Y, want, 0.01) {
+			t.Errorf("GravityScale %v: velocity after 1s = %v, want %v", scale, body.Velocity.Y, want)
+		}
+	}
+}
+
+func TestLinearDampingDecaysExponentially(t *testing.T) {
+	w, body := newLimitsBody(t, 0)
+	body.LinearDamping = 2
+	body.Velocity = rl.NewVector2(300, -400)
+	stepSeconds(w, 1)
+
+	factor := float32(math.Exp(-2))
+	if !near(body.Velocity.X, 300*factor, 0.01) || !near(body.Velocity.Y, -400*factor, 0.01) {
+		t.Errorf("velocity after 1s = %v, want %v", body.Velocity, rl.Vector2Scale(rl.NewVector2(300, -400), factor))
+	}
+}
+
+func TestAngularDampingDecaysExponentially(t *testing.T) {
+	w, body := newLimitsBody(t, 0)
+	body.AngularDamping = 2
+	body.AngularVelocity = 10
+	stepSeconds(w, 1)
+
+	if want := 10 * float32(math.Exp(-2)); !near(body.AngularVelocity, want, 0.01) {
+		t.Errorf("angular velocity after 1s = %v, want %v", body.AngularVelocity, want)
+	}
+}
+
+func TestMaxSpeedLimitsMagnitude(t *testing.T) {
+	w, body := newLimitsBody(t, 1000)
+	body.MaxSpeed = 200
+	body.Velocity = rl.NewVector2(300, 0)
+	w.Step(1)
+	// The speed is limited as a whole, the direction is kept
+	if speed := rl.Vector2Length(body.Velocity); !near(speed, 200, 0.001) {
+		t.Errorf("speed = %v, want 200", speed)
+	}
+	if body.Velocity.X <= body.Velocity.Y {
+		t.Errorf("velocity %v lost its direction", body.Velocity)
+	}
+
+	stepSeconds(w, 1)
+	if speed := rl.Vector2Length(body.Velocity); !near(speed, 200, 0.001) {
+		t.Errorf("speed while falling = %v, want 200", speed)
+	}
+}
+
+func TestMaxVelocityLimitsEachAxis(t *testing.T) {
+	w, body := newLimitsBody(t, 0)
+	body.MaxVelocity.Y = 200
+	body.Velocity = rl.NewVector2(300, -500)
+	w.Step(1)
+	if body.Velocity.X != 300 {
+		t.Errorf("unlimited axis velocity = %v, want 300", body.Velocity.X)
+	}
+	if body.Velocity.Y != -200 {
+		t.Errorf("limited axis velocity = %v, want -200", body.Velocity.Y)
+	}
+
+	w.SetGravity(0, 1000)
+	stepSeconds(w, 1)
+	if body.Velocity.X != 300 || body.Velocity.Y != 200 {
+		t.Errorf("velocity while falling = %v, want {300 200}", body.Velocity)
+	}
+}
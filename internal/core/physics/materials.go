@@ -0,0 +1,97 @@
+package physics
+
+import (
+	"math"
+)
+
+// Material type, named surface properties shared by bodies
+type Material struct {
+	// Name identifying the material in the pair table
+	Name string `json:"name"`
+	// Friction when the body has not movement (0 to 1)
+	StaticFriction float32 `json:"staticFriction"`
+	// Friction when the body has movement (0 to 1)
+	DynamicFriction float32 `json:"dynamicFriction"`
+	// Restitution coefficient of the body (0 to 1)
+	Restitution float32 `json:"restitution"`
+}
+
+// ContactProperties type, restitution and friction used to solve a contact
+type ContactProperties struct {
+	// Restitution coefficient of the contact (0 to 1)
+	Restitution float32 `json:"restitution"`
+	// Friction when the bodies have not relative movement (0 to 1)
+	StaticFriction float32 `json:"staticFriction"`
+	// Friction when the bodies have relative movement (0 to 1)
+	DynamicFriction float32 `json:"dynamicFriction"`
+}
+
+// materialPairKey - Identifies a pair of materials regardless of their order
+type materialPairKey struct {
+	a, b string
+}
+
+// NewMaterial - Creates a named material
+func NewMaterial(name string, staticFriction, dynamicFriction, restitution float32) *Material {
+	return &Material{
+		Name:            name,
+		StaticFriction:  staticFriction,
+		DynamicFriction: dynamicFriction,
+		Restitution:     restitution,
+	}
+}
+
+// SetMaterial - Assigns a material to the body, copying its friction and restitution. A nil
+// material keeps the current body values
+func (b *Body) SetMaterial(material *Material) {
+	b.Material = material
+	if material != nil {
+		b.StaticFriction = material.StaticFriction
+		b.DynamicFriction = material.DynamicFriction
+		b.Restitution = material.Restitution
+	}
+}
+
+// SetMaterialPair - Overrides the mixed restitution and friction of the contacts between
+// bodies made of two materials, in any order
+func (w *World) SetMaterialPair(a, b string, properties ContactProperties) {
+	w.materialPairs[newMaterialPairKey(a, b)] = properties
+}
+
+// RemoveMaterialPair - Removes the override of a pair of materials
+func (w *World) RemoveMaterialPair(a, b string) {
+	delete(w.materialPairs, newMaterialPairKey(a, b))
+}
+
+// GetMaterialPair - Returns the override of a pair of materials, if any
+func (w *World) GetMaterialPair(a, b string) (ContactProperties, bool) {
+	properties, exists := w.materialPairs[newMaterialPairKey(a, b)]
+	return properties, exists
+}
+
+// newMaterialPairKey - Returns the key of the pair formed by two material names
+func newMaterialPairKey(a, b string) materialPairKey {
+	if a > b {
+		a, b = b, a
+	}
+	return materialPairKey{a: a, b: b}
+}
+
+// mixMaterials - Sets the manifold restitution and friction from the pair table when both
+// bodies have a material with an override, otherwise mixes the bodies values
+func (w *World) mixMaterials(manifold *Manifold) {
+	bodyA, bodyB := manifold.BodyA, manifold.BodyB
+	if bodyA.Material != nil && bodyB.Material != nil {
+		if properties, exists := w.GetMaterialPair(bodyA.Material.Name, bodyB.Material.Name); exists {
+			manifold.Restitution = properties.Restitution
+			manifold.StaticFriction = properties.StaticFriction
+			manifold.DynamicFriction = properties.DynamicFriction
+			return
+		}
+	}
+
+	// Calculate average restitution, static and dynamic friction
+	manifold.Restitution = float32(math.Sqrt(float64(bodyA.Restitution * bodyB.Restitution)))
+	manifold.StaticFriction = float32(math.Sqrt(float64(bodyA.StaticFriction * bodyB.StaticFriction)))
+	manifold.DynamicFriction = float32(math.Sqrt(float64(bodyA.DynamicFriction * bodyB.DynamicFriction)))
+}
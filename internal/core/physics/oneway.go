@@ -0,0 +1,51 @@
+package physics
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SetOneWay - Makes the body solid only for bodies touching its side facing a direction, e.g.
+// (0, -1) for a ledge that can be crossed from below but landed on from above. Bodies crossing
+// it from any other side go through it until they stop touching it
+func (b *Body) SetOneWay(direction rl.Vector2) {
+	b.OneWay = true
+	b.OneWayDirection = rl.Vector2Normalize(direction)
+}
+
+// oneWayAccepts - Checks if the manifold normal points out of the solid side of the one-way
+// bodies of the manifold
+func oneWayAccepts(manifold *Manifold) bool {
+	// The normal goes from the first body to the second one
+	if manifold.BodyA.OneWay && rl.Vector2DotProduct(manifold.Normal, manifold.BodyA.OneWayDirection) <= 0 {
+		return false
+	}
+	if manifold.BodyB.OneWay && rl.Vector2DotProduct(manifold.Normal, manifold.BodyB.OneWayDirection) >= 0 {
+		return false
+	}
+	return true
+}
+
+// discardOneWay - Discards the contacts of a manifold against a one-way body crossed from the
+// wrong side, and keeps discarding them on the following steps until the bodies separate.
+// Returns true when the contacts are discarded
+func (w *World) discardOneWay(manifold *Manifold) bool {
+	if manifold.ContactsCount == 0 || (!manifold.BodyA.OneWay && !manifold.BodyB.OneWay) {
+		return false
+	}
+
+	key := newContactKey(manifold.BodyA, manifold.BodyB)
+	if _, crossing := w.oneWayCrossing[key]; !crossing && oneWayAccepts(manifold) {
+		return false
+	}
+	w.nextOneWayCrossing[key] = struct{}{}
+	manifold.ContactsCount = 0
+	manifold.Penetration = 0
+	return true
+}
+
+// swapOneWayCrossing - Makes the pairs crossing one-way bodies found during the current step
+// the previous ones, forgetting the pairs that separated
+func (w *World) swapOneWayCrossing() {
+	w.oneWayCrossing, w.nextOneWayCrossing = w.nextOneWayCrossing, w.oneWayCrossing
+	clear(w.nextOneWayCrossing)
+}
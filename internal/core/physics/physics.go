@@ -4,8 +4,8 @@
 package physics
 
 import (
+	"errors"
 	"math"
-	"math/rand"
 	"time"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
@@ -22,6 +22,31 @@ const (
 	PolygonShape
 )
 
+// BodyType type
+type BodyType int
+
+// Physics body types
+const (
+	// Moved by forces, gravity and collision impulses
+	DynamicBody BodyType = iota
+	// Never moves on its own
+	StaticBody
+	// Moved only by its velocity or a target position, pushes dynamic bodies but is never
+	// affected by impulses or gravity
+	KinematicBody
+)
+
+// CollisionLayer type, a bitfield of collision categories
+type CollisionLayer uint32
+
+// Collision layers
+const (
+	// Layer assigned to new bodies
+	LayerDefault CollisionLayer = 1
+	// Every layer, used as the default mask of new bodies
+	LayerAll CollisionLayer = ^CollisionLayer(0)
+)
+
 // Polygon type
 type Polygon struct {
 	// Current used vertex and normals count
@@ -50,6 +75,8 @@ type Shape struct {
 type Body struct {
 	// Reference unique identifier
 	ID int
+	// Physics body type (dynamic, static or kinematic)
+	Type BodyType
 	// Enabled dynamics state (collisions are calculated anyway)
 	Enabled bool
 	// Paused state: when true, the body is completely ignored by the physics
@@ -67,6 +94,10 @@ type Body struct {
 	Torque float32
 	// Rotation in radians
 	Orient float32
+	// Position at the beginning of the last step, used for render interpolation
+	PreviousPosition rl.Vector2
+	// Rotation at the beginning of the last step, used for render interpolation
+	PreviousOrient float32
 	// Moment of inertia
 	Inertia float32
 	// Inverse value of inertia
@@ -81,10 +112,27 @@ type Body struct {
 	DynamicFriction float32
 	// Restitution coefficient of the body (0 to 1)
 	Restitution float32
+	// Named material the friction and restitution come from, nil when set by hand
+	Material *Material
 	// Apply gravity force to dynamics
 	UseGravity bool
+	// Multiplier of the world gravity applied to the body
+	GravityScale float32
+	// Linear damping rate, the velocity is divided by 1 + LinearDamping * dt over each step of dt
+	// seconds, so it decays by about e^-LinearDamping per second. 0 keeps the velocity untouched
+	LinearDamping float32
+	// Angular damping rate, applied to the angular velocity like LinearDamping
+	AngularDamping float32
+	// Maximum linear speed in pixels per second, 0 for no limit
+	MaxSpeed float32
+	// Maximum absolute linear velocity on each axis in pixels per second, 0 on an axis for no limit
+	MaxVelocity rl.Vector2
 	// Physics grounded on other body state
 	IsGrounded bool
+	// Sleeping state: resting bodies are not integrated until something wakes them up
+	IsSleeping bool
+	// Allows the body to fall asleep when resting
+	AllowSleep bool
 	// Physics rotation constraint
 	FreezeOrient bool
 	// Physics body shape information (type, radius, vertices, normals)
@@ -93,8 +141,36 @@ type Body struct {
 	Tag string
 	// If true, this body acts as a trigger (detects collisions but doesn't resolve them physically)
 	IsTrigger bool
-	// Collision callback function
-	OnCollision func(other *Body, manifold *Manifold)
+	// If true, this body only collides with bodies touching its side facing OneWayDirection
+	OneWay bool
+	// Normalized direction the solid side of a one-way body faces
+	OneWayDirection rl.Vector2
+	// Layers the body belongs to
+	Category CollisionLayer
+	// Layers the body collides with
+	Mask CollisionLayer
+	// Sweeps the body motion every step so it doesn't tunnel through thin bodies when moving fast
+	ContinuousCollision bool
+	// Called on the first step the body touches another body
+	OnEnter func(other *Body, manifold *Manifold)
+	// Called on every following step while the body keeps touching the other body
+	OnStay func(other *Body, manifold *Manifold)
+	// Called on the first step the body stops touching another body
+	OnExit func(other *Body)
+	// Target position of a kinematic body for the next step
+	target rl.Vector2
+	// Whether a kinematic body has a pending target position
+	hasTarget bool
+	// Whether the body waits to be removed from its world
+	destroyed bool
+	// Position at the beginning of the step, where continuous collision sweeps start
+	sweepStart rl.Vector2
+	// Time the body has been resting, in seconds
+	sleepTime float32
+	// Index of the body in the bodies slice while building islands
+	islandIndex int
+	// Physics world the body belongs to
+	world *World
 }
 
 // Manifold type
@@ -123,8 +199,6 @@ type Manifold struct {
 
 // Constants
 const (
-	maxBodies      = 64
-	maxManifolds   = 4096
 	maxVertices    = 24
 	circleVertices = 24
 
@@ -137,72 +211,110 @@ const (
 	physacK  = 1.0 / 3.0
 )
 
-// Globals
+// Errors
 var (
-	// Offset time for MONOTONIC clock
-	baseTime = time.Now()
-
-	// Start time in seconds
-	startTime float32
+	// ErrNilWorld is returned when a body is requested from a nil physics world
+	ErrNilWorld = errors.New("physics: nil world")
+	// ErrInvalidShape is returned when the body shape parameters are out of range
+	ErrInvalidShape = errors.New("physics: invalid body shape")
+)
 
+// World type
+type World struct {
 	// Fixed physics step in seconds (original used ms; normalized here)
-	deltaTime float32 = 1.0 / 60.0 / 10.0
-
-	// Current time in seconds
-	currentTime float32
-
-	// Hi-res clock frequency
-	frequency uint64 = 0
-
+	deltaTime float32
 	// Physics time step delta time accumulator
 	accumulator float32
-
 	// Physics world gravity force
-	gravityForce = rl.NewVector2(0, 9.81)
-
-	// Physics bodies pointers array
-	bodies [64]*Body
-
-	// Physics world current bodies counter
-	bodiesCount int
-
-	// Physics bodies pointers array
-	manifolds [4096]*Manifold
-
-	// Physics world current manifolds counter
-	manifoldsCount int
-)
+	gravityForce rl.Vector2
+	// Physics bodies pointers, grows as needed
+	bodies []*Body
+	// Next unique identifier handed to a new body
+	nextBodyID int
+	// Physics manifolds pointers, grows as needed
+	manifolds []*Manifold
+	// Disables the broadphase, testing every pair of bodies
+	disableBroadphase bool
+	// Broadphase proxies, reused between steps
+	proxies []proxy
+	// Broadphase candidate pairs, reused between steps
+	pairs []bodyPair
+	// Contacts found during the previous step, in detection order
+	contacts []contact
+	// Contacts found during the current step, in detection order
+	nextContacts []contact
+	// Lookup of the previous step contacts by body pair
+	contactIndex map[contactKey]struct{}
+	// Lookup of the current step contacts by body pair
+	nextContactIndex map[contactKey]struct{}
+	// Nesting depth of Lock calls, bodies are destroyed right away only when zero
+	lockDepth int
+	// Bodies destroyed while the world was locked
+	pendingDestroy []*Body
+	// Trigger contacts crossed by continuous collision bodies during the previous step
+	sweptContacts []Manifold
+	// Disables putting resting bodies to sleep
+	disableSleeping bool
+	// Island parent of every body index, reused between steps
+	islands []int
+	// Minimum resting time of every island, reused between steps
+	islandSleepTimes []float32
+	// Physics joints pointers, grows as needed
+	joints []*Joint
+	// Next unique identifier handed to a new joint
+	nextJointID int
+	// Contact properties overriding the mix of specific material pairs
+	materialPairs map[materialPairKey]ContactProperties
+	// Pairs crossing a one-way body during the previous step
+	oneWayCrossing map[contactKey]struct{}
+	// Pairs crossing a one-way body during the current step
+	nextOneWayCrossing map[contactKey]struct{}
+	// Statistics of the last Update or Step call
+	stats Stats
+}
 
-// Init - Initializes physics values, pointers and creates physics loop thread
-func Init() {
-	initTimer()
-	accumulator = 0
+// NewWorld - Initializes a new physics world with its own bodies, manifolds, gravity and time step
+func NewWorld() *World {
+	w := &World{
+		deltaTime:          1.0 / 60.0 / 10.0,
+		gravityForce:       rl.NewVector2(0, 9.81),
+		contactIndex:       make(map[contactKey]struct{}),
+		nextContactIndex:   make(map[contactKey]struct{}),
+		materialPairs:      make(map[materialPairKey]ContactProperties),
+		oneWayCrossing:     make(map[contactKey]struct{}),
+		nextOneWayCrossing: make(map[contactKey]struct{}),
+	}
+	return w
 }
 
-// SetGravity - Sets physics global gravity force
-func SetGravity(x, y float32) {
-	gravityForce.X = x
-	gravityForce.Y = y
+// SetGravity - Sets physics world gravity force
+func (w *World) SetGravity(x, y float32) {
+	w.gravityForce.X = x
+	w.gravityForce.Y = y
 }
 
 // NewBodyCircle - Creates a new circle physics body with generic parameters
-func NewBodyCircle(tag string, pos rl.Vector2, radius, density float32) *Body {
-	newID := findAvailableBodyIndex()
-	if newID < 0 {
-		return nil
+func (w *World) NewBodyCircle(tag string, pos rl.Vector2, radius, density float32) (*Body, error) {
+	if w == nil {
+		return nil, ErrNilWorld
+	}
+	if radius <= 0 || density < 0 {
+		return nil, ErrInvalidShape
 	}
+	newID := w.newBodyID()
 
 	// Initialize new body with generic values
 	newBody := &Body{
-		ID:              newID,
-		Enabled:         true,
-		Paused:          false,
-		Position:        pos,
-		Velocity:        rl.Vector2{},
-		Force:           rl.Vector2{},
-		AngularVelocity: 0.0,
-		Torque:          0.0,
-		Orient:          0.0,
+		ID:               newID,
+		Enabled:          true,
+		Paused:           false,
+		Position:         pos,
+		PreviousPosition: pos,
+		Velocity:         rl.Vector2{},
+		Force:            rl.Vector2{},
+		AngularVelocity:  0.0,
+		Torque:           0.0,
+		Orient:           0.0,
 		Shape: Shape{
 			Type:       CircleShape,
 			Radius:     radius,
@@ -213,10 +325,21 @@ func NewBodyCircle(tag string, pos rl.Vector2, radius, density float32) *Body {
 		DynamicFriction: 0.2,
 		Restitution:     0.0,
 		UseGravity:      true,
+		GravityScale:    1.0,
+		LinearDamping:   0.0,
+		AngularDamping:  0.0,
+		MaxSpeed:        0.0,
+		MaxVelocity:     rl.Vector2{},
 		IsGrounded:      false,
+		IsSleeping:      false,
+		AllowSleep:      true,
 		FreezeOrient:    false,
 		Tag:             tag,
 		IsTrigger:       false,
+		Category:        LayerDefault,
+		Mask:            LayerAll,
+		Type:            DynamicBody,
+		world:           w,
 	}
 
 	newBody.Shape.Body = newBody
@@ -225,44 +348,61 @@ func NewBodyCircle(tag string, pos rl.Vector2, radius, density float32) *Body {
 	newBody.InverseMass = safeDiv(1.0, newBody.Mass)
 	newBody.Inertia = newBody.Mass * radius * radius
 	newBody.InverseInertia = safeDiv(1.0, newBody.Inertia)
+	if newBody.InverseMass == 0 {
+		newBody.Type = StaticBody
+	}
 
-	// Add new body to bodies pointers array and update bodies count
-	bodies[bodiesCount] = newBody
-	bodiesCount++
-	return newBody
+	// Add new body to bodies pointers slice
+	w.bodies = append(w.bodies, newBody)
+	return newBody, nil
 }
 
 // NewBodyRectangle - Creates a new rectangle physics body with generic parameters
-func NewBodyRectangle(tag string, pos rl.Vector2, width, height, density float32) *Body {
-	newID := findAvailableBodyIndex()
-	if newID < 0 {
-		return nil
+func (w *World) NewBodyRectangle(tag string, pos rl.Vector2, width, height, density float32) (*Body, error) {
+	if w == nil {
+		return nil, ErrNilWorld
+	}
+	if width <= 0 || height <= 0 || density < 0 {
+		return nil, ErrInvalidShape
 	}
+	newID := w.newBodyID()
 
 	// Initialize new body with generic values
 	newBody := &Body{
-		ID:              newID,
-		Enabled:         true,
-		Paused:          false,
-		Position:        pos,
-		Velocity:        rl.Vector2{},
-		Force:           rl.Vector2{},
-		AngularVelocity: 0.0,
-		Torque:          0.0,
-		Orient:          0.0,
+		ID:               newID,
+		Enabled:          true,
+		Paused:           false,
+		Position:         pos,
+		PreviousPosition: pos,
+		Velocity:         rl.Vector2{},
+		Force:            rl.Vector2{},
+		AngularVelocity:  0.0,
+		Torque:           0.0,
+		Orient:           0.0,
 		Shape: Shape{
 			Type:       PolygonShape,
 			Transform:  rl.Mat2Radians(0.0),
-			VertexData: createRectanglePolygon(pos, rl.NewVector2(width, height)),
+			VertexData: createRectanglePolygon(rl.NewVector2(width, height)),
 		},
 		StaticFriction:  0.4,
 		DynamicFriction: 0.2,
 		Restitution:     0.0,
 		UseGravity:      true,
+		GravityScale:    1.0,
+		LinearDamping:   0.0,
+		AngularDamping:  0.0,
+		MaxSpeed:        0.0,
+		MaxVelocity:     rl.Vector2{},
 		IsGrounded:      false,
+		IsSleeping:      false,
+		AllowSleep:      true,
 		FreezeOrient:    false,
 		Tag:             tag,
 		IsTrigger:       false,
+		Category:        LayerDefault,
+		Mask:            LayerAll,
+		Type:            DynamicBody,
+		world:           w,
 	}
 
 	// Calculate centroid and moment of inertia
@@ -305,44 +445,61 @@ func NewBodyRectangle(tag string, pos rl.Vector2, width, height, density float32
 	newBody.InverseMass = safeDiv(1.0, newBody.Mass)
 	newBody.Inertia = density * inertia
 	newBody.InverseInertia = safeDiv(1.0, newBody.Inertia)
+	if newBody.InverseMass == 0 {
+		newBody.Type = StaticBody
+	}
 
-	// Add new body to bodies pointers array and update bodies count
-	bodies[bodiesCount] = newBody
-	bodiesCount++
-	return newBody
+	// Add new body to bodies pointers slice
+	w.bodies = append(w.bodies, newBody)
+	return newBody, nil
 }
 
 // NewBodyPolygon - Creates a new polygon physics body with generic parameters
-func NewBodyPolygon(tag string, pos rl.Vector2, radius float32, sides int, density float32) *Body {
-	newID := findAvailableBodyIndex()
-	if newID < 0 {
-		return nil
+func (w *World) NewBodyPolygon(tag string, pos rl.Vector2, radius float32, sides int, density float32) (*Body, error) {
+	if w == nil {
+		return nil, ErrNilWorld
 	}
+	if radius <= 0 || sides < 3 || sides > maxVertices || density < 0 {
+		return nil, ErrInvalidShape
+	}
+	newID := w.newBodyID()
 
 	// Initialize new body with generic values
 	newBody := &Body{
-		ID:              newID,
-		Enabled:         true,
-		Paused:          false,
-		Position:        pos,
-		Velocity:        rl.Vector2{},
-		Force:           rl.Vector2{},
-		AngularVelocity: 0.0,
-		Torque:          0.0,
-		Orient:          0.0,
+		ID:               newID,
+		Enabled:          true,
+		Paused:           false,
+		Position:         pos,
+		PreviousPosition: pos,
+		Velocity:         rl.Vector2{},
+		Force:            rl.Vector2{},
+		AngularVelocity:  0.0,
+		Torque:           0.0,
+		Orient:           0.0,
 		Shape: Shape{
 			Type:       PolygonShape,
 			Transform:  rl.Mat2Radians(0),
-			VertexData: createRandomPolygon(radius, sides),
+			VertexData: createRegularPolygon(radius, sides),
 		},
 		StaticFriction:  0.4,
 		DynamicFriction: 0.2,
 		Restitution:     0.0,
 		UseGravity:      true,
+		GravityScale:    1.0,
+		LinearDamping:   0.0,
+		AngularDamping:  0.0,
+		MaxSpeed:        0.0,
+		MaxVelocity:     rl.Vector2{},
 		IsGrounded:      false,
+		IsSleeping:      false,
+		AllowSleep:      true,
 		FreezeOrient:    false,
 		Tag:             tag,
 		IsTrigger:       false,
+		Category:        LayerDefault,
+		Mask:            LayerAll,
+		Type:            DynamicBody,
+		world:           w,
 	}
 
 	newBody.Shape.Body = newBody
@@ -386,73 +543,102 @@ func NewBodyPolygon(tag string, pos rl.Vector2, radius float32, sides int, densi
 	newBody.InverseMass = safeDiv(1.0, newBody.Mass)
 	newBody.Inertia = density * inertia
 	newBody.InverseInertia = safeDiv(1.0, newBody.Inertia)
+	if newBody.InverseMass == 0 {
+		newBody.Type = StaticBody
+	}
 
-	// Add new body to bodies pointers array and update bodies count
-	bodies[bodiesCount] = newBody
-	bodiesCount++
-	return newBody
+	// Add new body to bodies pointers slice
+	w.bodies = append(w.bodies, newBody)
+	return newBody, nil
 }
 
-// NewTriggerCircle - Creates a new circle trigger body that only detects collisions
-func NewTriggerCircle(tag string, pos rl.Vector2, radius float32) *Body {
-	body := NewBodyCircle(tag, pos, radius, 1.0) // Density doesn't matter for triggers
-	if body != nil {
-		body.IsTrigger = true
-		body.UseGravity = false
-		body.Mass = 0
-		body.InverseMass = 0
-		body.Inertia = 0
-		body.InverseInertia = 0
+// NewTriggerCircle - Creates a new kinematic circle trigger body that only detects collisions,
+// moved only by its velocity
+func (w *World) NewTriggerCircle(tag string, pos rl.Vector2, radius float32) (*Body, error) {
+	body, err := w.NewBodyCircle(tag, pos, radius, 1.0) // Density doesn't matter for kinematic bodies
+	if err != nil {
+		return nil, err
 	}
-	return body
+	body.SetKinematic()
+	body.SetAsTrigger()
+	return body, nil
 }
 
-// NewTriggerRectangle - Creates a new rectangle trigger body that only detects collisions
-func NewTriggerRectangle(tag string, pos rl.Vector2, width, height float32) *Body {
-	body := NewBodyRectangle(tag, pos, width, height, 1.0) // Density doesn't matter for triggers
-	if body != nil {
-		body.IsTrigger = true
-		body.UseGravity = false
-		body.Mass = 0
-		body.InverseMass = 0
-		body.Inertia = 0
-		body.InverseInertia = 0
+// NewTriggerRectangle - Creates a new kinematic rectangle trigger body that only detects
+// collisions, moved only by its velocity
+func (w *World) NewTriggerRectangle(tag string, pos rl.Vector2, width, height float32) (*Body, error) {
+	body, err := w.NewBodyRectangle(tag, pos, width, height, 1.0) // Density doesn't matter for kinematic bodies
+	if err != nil {
+		return nil, err
 	}
-	return body
+	body.SetKinematic()
+	body.SetAsTrigger()
+	return body, nil
 }
 
-// SetAsTrigger - Converts an existing body into a trigger
+// NewKinematicRectangle - Creates a new rectangle kinematic body moved only by its velocity
+func (w *World) NewKinematicRectangle(tag string, pos rl.Vector2, width, height float32) (*Body, error) {
+	body, err := w.NewBodyRectangle(tag, pos, width, height, 1.0) // Density doesn't matter for kinematic bodies
+	if err != nil {
+		return nil, err
+	}
+	body.SetKinematic()
+	return body, nil
+}
+
+// SetAsTrigger - Converts an existing body into a trigger that only detects collisions. The body
+// type is kept, so a dynamic trigger still falls and a static one stays in place
 func (b *Body) SetAsTrigger() {
 	b.IsTrigger = true
+}
+
+// SetKinematic - Converts an existing body into a kinematic body
+func (b *Body) SetKinematic() {
+	b.Type = KinematicBody
 	b.UseGravity = false
 	b.Mass = 0
 	b.InverseMass = 0
 	b.Inertia = 0
 	b.InverseInertia = 0
+}
 
+// MoveTo - Moves a kinematic body to a target position during the next step, pushing the
+// dynamic bodies on its way. Has no effect on other body types
+func (b *Body) MoveTo(target rl.Vector2) {
+	if b.Type != KinematicBody {
+		return
+	}
+	b.target = target
+	b.hasTarget = true
 }
 
 // Reset - Destroys created physics bodies and manifolds
-func Reset() {
-	Close()
+func (w *World) Reset() {
+	w.Close()
 }
 
-// AddForce - Adds a force to a physics body
+// AddForce - Adds a force to a physics body, waking it up unless the force is zero
 func AddForce(body *Body, force rl.Vector2) {
 	if body != nil {
 		body.Force = rl.Vector2Add(body.Force, force)
+		if force.X != 0 || force.Y != 0 {
+			body.WakeUp()
+		}
 	}
 }
 
-// AddTorque - Adds an angular force to a physics body
+// AddTorque - Adds an angular force to a physics body, waking it up unless the amount is zero
 func AddTorque(body *Body, amount float32) {
 	if body != nil {
 		body.Torque += amount
+		if amount != 0 {
+			body.WakeUp()
+		}
 	}
 }
 
 // Shatter - Shatters a polygon shape physics body to little physics bodies with explosion force
-func Shatter(body *Body, position rl.Vector2, force float32) {
+func (w *World) Shatter(body *Body, position rl.Vector2, force float32) {
 	if body == nil || body.Shape.Type != PolygonShape {
 		return
 	}
@@ -502,7 +688,10 @@ func Shatter(body *Body, position rl.Vector2, force float32) {
 		center = rl.Vector2Add(bodyPos, center)
 		offset := rl.Vector2Subtract(center, bodyPos)
 
-		var newBody *Body = NewBodyPolygon("shard", center, 10, 3, 10)
+		newBody, err := w.NewBodyPolygon("shard", center, 10, 3, 10)
+		if err != nil {
+			continue
+		}
 		var newData Polygon = Polygon{}
 		newData.VertexCount = 3
 		newData.Positions[0] = rl.Vector2Subtract(vertices[i], offset)
@@ -582,41 +771,41 @@ func Shatter(body *Body, position rl.Vector2, force float32) {
 }
 
 // GetBodies - Returns the slice of created physics bodies
-func GetBodies() []*Body {
-	return bodies[:bodiesCount]
+func (w *World) GetBodies() []*Body {
+	return w.bodies
 }
 
 // GetBodiesCount - Returns the current amount of created physics bodies
-func GetBodiesCount() int {
-	return bodiesCount
+func (w *World) GetBodiesCount() int {
+	return len(w.bodies)
 }
 
 // GetBody - Returns a physics body of the bodies pool at a specific index
-func GetBody(index int) *Body {
-	return bodies[index]
+func (w *World) GetBody(index int) *Body {
+	return w.bodies[index]
 }
 
 // GetShapeType - Returns the physics body shape type (PHYSICS_CIRCLE or PHYSICS_POLYGON)
-func GetShapeType(index int) ShapeType {
+func (w *World) GetShapeType(index int) ShapeType {
 	result := ShapeType(-1)
-	if index < bodiesCount {
-		if bodies[index] != nil {
-			result = bodies[index].Shape.Type
+	if index < len(w.bodies) {
+		if w.bodies[index] != nil {
+			result = w.bodies[index].Shape.Type
 		}
 	}
 	return result
 }
 
 // GetShapeVerticesCount - Returns the amount of vertices of a physics body shape
-func GetShapeVerticesCount(index int) int {
+func (w *World) GetShapeVerticesCount(index int) int {
 	var result int = 0
-	if index < bodiesCount {
-		if bodies[index] != nil {
-			switch bodies[index].Shape.Type {
+	if index < len(w.bodies) {
+		if w.bodies[index] != nil {
+			switch w.bodies[index].Shape.Type {
 			case CircleShape:
 				result = circleVertices
 			case PolygonShape:
-				result = bodies[index].Shape.VertexData.VertexCount
+				result = w.bodies[index].Shape.VertexData.VertexCount
 			default:
 			}
 		}
@@ -642,6 +831,17 @@ func (b *Body) GetShapeVertex(vertex int) rl.Vector2 {
 	return position
 }
 
+// SetCollisionFilter - Sets the layers the body belongs to and the layers it collides with
+func (b *Body) SetCollisionFilter(category, mask CollisionLayer) {
+	b.Category = category
+	b.Mask = mask
+}
+
+// CollidesWith - Checks if the collision filters of both bodies accept each other
+func (b *Body) CollidesWith(other *Body) bool {
+	return b.Category&other.Mask != 0 && other.Category&b.Mask != 0
+}
+
 // SetBodyRotation - Sets physics body shape transform based on radians parameter
 func (b *Body) SetRotation(radians float32) {
 	b.Orient = radians
@@ -650,69 +850,100 @@ func (b *Body) SetRotation(radians float32) {
 	}
 }
 
-// Destroy - Unitializes and destroy a physics body
+// Destroy - Unitializes and destroy a physics body. While the world is stepping or locked the
+// body is only marked as destroyed and removed once the world is unlocked
 func (b *Body) Destroy() {
-	id := b.ID
-	index := -1
-	for i := 0; i < bodiesCount; i++ {
-		if bodies[i].ID == id {
-			index = i
-			break
-		}
+	w := b.world
+	if w == nil || b.destroyed {
+		return
 	}
-	if index == -1 {
+	if w.lockDepth > 0 {
+		b.destroyed = true
+		w.pendingDestroy = append(w.pendingDestroy, b)
 		return
 	}
+	w.removeBody(b)
+}
 
-	bodies[index] = nil
-
-	// Reorder physics bodies pointers array and its catched index
-	for i := index; i+1 < bodiesCount; i++ {
-		bodies[i] = bodies[i+1]
-	}
+// IsDestroyed - Checks if the body was destroyed or is waiting to be removed from its world
+func (b *Body) IsDestroyed() bool {
+	return b.world == nil || b.destroyed
+}
 
-	// Update physics bodies count
-	bodiesCount--
+// Lock - Defers every body destruction until the matching Unlock, so the bodies slice is
+// never mutated while it is being iterated. Calls can be nested
+func (w *World) Lock() {
+	w.lockDepth++
 }
 
-// Close - Unitializes physics pointers
-func Close() {
-	// Unitialize physics manifolds dynamic memory allocations
-	for i := manifoldsCount - 1; i >= 0; i-- {
-		destroyManifold(manifolds[i])
+// Unlock - Releases a Lock, removing the bodies destroyed meanwhile once no lock is left
+func (w *World) Unlock() {
+	if w.lockDepth == 0 {
+		return
 	}
-
-	// Unitialize physics bodies dynamic memory allocations
-	for i := bodiesCount - 1; i >= 0; i-- {
-		bodies[i].Destroy()
+	w.lockDepth--
+	if w.lockDepth > 0 {
+		return
 	}
+	for i, body := range w.pendingDestroy {
+		w.removeBody(body)
+		w.pendingDestroy[i] = nil
+	}
+	w.pendingDestroy = w.pendingDestroy[:0]
 }
 
-// findAvailableBodyIndex - Finds a valid index for a new physics body initialization
-func findAvailableBodyIndex() int {
+// removeBody - Removes a physics body from the bodies slice
+func (w *World) removeBody(b *Body) {
 	index := -1
-	for i := 0; i < maxBodies; i++ {
-		currentID := i
-
-		// Check if current id already exist in other physics body
-		for k := 0; k < bodiesCount; k++ {
-			if bodies[k].ID == currentID {
-				currentID++
-				break
-			}
-		}
-
-		// If it is not used, use it as new physics body id
-		if currentID == i {
+	for i, body := range w.bodies {
+		if body == b {
 			index = i
 			break
 		}
 	}
-	return index
+	if index == -1 {
+		return
+	}
+
+	// Joints can't outlive their bodies
+	w.destroyBodyJoints(b)
+
+	// Remove the body keeping the order of the remaining ones
+	copy(w.bodies[index:], w.bodies[index+1:])
+	w.bodies[len(w.bodies)-1] = nil
+	w.bodies = w.bodies[:len(w.bodies)-1]
+	b.world = nil
+	b.destroyed = false
+}
+
+// Close - Unitializes physics pointers
+func (w *World) Close() {
+	// Unitialize physics manifolds dynamic memory allocations
+	w.clearManifolds()
+	w.clearContacts()
+	w.clearJoints()
+	clear(w.sweptContacts)
+	w.sweptContacts = w.sweptContacts[:0]
+	clear(w.oneWayCrossing)
+	clear(w.nextOneWayCrossing)
+
+	// Unitialize physics bodies dynamic memory allocations, ignoring any lock
+	for i := len(w.bodies) - 1; i >= 0; i-- {
+		w.removeBody(w.bodies[i])
+	}
+	clear(w.pendingDestroy)
+	w.pendingDestroy = w.pendingDestroy[:0]
+}
+
+// newBodyID - Returns a unique identifier for a new physics body
+func (w *World) newBodyID() int {
+	id := w.nextBodyID
+	w.nextBodyID++
+	return id
 }
 
-// createRandomPolygon - Creates a random polygon shape with max vertex distance from polygon pivot
-func createRandomPolygon(radius float32, sides int) Polygon {
+// createRegularPolygon - Creates a regular polygon shape with its vertices at a distance from the polygon pivot
+func createRegularPolygon(radius float32, sides int) Polygon {
 	var data Polygon = Polygon{}
 	data.VertexCount = sides
 
@@ -734,16 +965,17 @@ func createRandomPolygon(radius float32, sides int) Polygon {
 	return data
 }
 
-// createRectanglePolygon - Creates a rectangle polygon shape based on a min and max positions
-func createRectanglePolygon(pos rl.Vector2, size rl.Vector2) Polygon {
+// createRectanglePolygon - Creates a rectangle polygon shape centered on the polygon pivot, so
+// its moment of inertia is computed around its centroid
+func createRectanglePolygon(size rl.Vector2) Polygon {
 	var data Polygon = Polygon{}
 	data.VertexCount = 4
 
 	// Calculate polygon vertices positions
-	data.Positions[0] = rl.NewVector2(pos.X+size.X/2, pos.Y-size.Y/2)
-	data.Positions[1] = rl.NewVector2(pos.X+size.X/2, pos.Y+size.Y/2)
-	data.Positions[2] = rl.NewVector2(pos.X-size.X/2, pos.Y+size.Y/2)
-	data.Positions[3] = rl.NewVector2(pos.X-size.X/2, pos.Y-size.Y/2)
+	data.Positions[0] = rl.NewVector2(size.X/2, -size.Y/2)
+	data.Positions[1] = rl.NewVector2(size.X/2, size.Y/2)
+	data.Positions[2] = rl.NewVector2(-size.X/2, size.Y/2)
+	data.Positions[3] = rl.NewVector2(-size.X/2, -size.Y/2)
 
 	// Calculate polygon faces normals
 	for i := 0; i < data.VertexCount; i++ {
@@ -758,155 +990,189 @@ func createRectanglePolygon(pos rl.Vector2, size rl.Vector2) Polygon {
 }
 
 // step - Does physics steps calculations (dynamics, collisions and position corrections)
-func step() {
+func (w *World) step() {
+	stepStart := time.Now()
+
+	// Defer destructions requested by callbacks until the step is done
+	w.Lock()
+	defer w.Unlock()
+
 	// Clear previous generated collisions information
-	for i := manifoldsCount - 1; i >= 0; i-- {
-		if manifold := manifolds[i]; manifold != nil {
-			destroyManifold(manifold)
+	w.clearManifolds()
+
+	// Remember where the bodies were for render interpolation
+	w.storePreviousTransforms()
+
+	// Turn kinematic target positions into velocities for this step
+	for _, body := range w.bodies {
+		if body.Type == KinematicBody && body.hasTarget && !body.Paused {
+			body.Velocity.X = (body.target.X - body.Position.X) / w.deltaTime
+			body.Velocity.Y = (body.target.Y - body.Position.Y) / w.deltaTime
 		}
 	}
 
-	// Reset physics bodies grounded state
-	for i := 0; i < bodiesCount; i++ {
-		bodies[i].IsGrounded = false
+	// Wake up the bodies linked to awake bodies
+	w.wakeJointedBodies()
+
+	// Reset physics bodies grounded state, sleeping bodies keep resting where they were
+	for i := 0; i < len(w.bodies); i++ {
+		if !w.bodies[i].IsSleeping {
+			w.bodies[i].IsGrounded = false
+		}
 	}
 
-	// Generate new collision information
-	for i := 0; i < bodiesCount; i++ {
-		bodyA := bodies[i]
-		if bodyA == nil || bodyA.Paused {
+	// Generate new collision information for the broadphase candidate pairs
+	for _, pair := range w.findPairs() {
+		if pair.a >= len(w.bodies) || pair.b >= len(w.bodies) {
+			continue
+		}
+		bodyA, bodyB := w.bodies[pair.a], w.bodies[pair.b]
+		if !canCollide(bodyA, bodyB) {
 			continue
 		}
 
-		for j := i + 1; j < bodiesCount; j++ {
-			var bodyB *Body = bodies[j]
-			if bodyB == nil || bodyB.Paused || bodyA.InverseMass == 0 && bodyB.InverseMass == 0 {
-				continue
-			}
-
-			manifold := createManifold(bodyA, bodyB)
-			solveManifold(manifold)
-
-			if manifold.ContactsCount > 0 {
-				// Only create persistent manifolds for physical collision resolution
-				// Triggers still call collision callbacks but don't participate in physics resolution
-				if !bodyA.IsTrigger && !bodyB.IsTrigger {
-					// Create a new manifold with same information as previously solved manifold and add it to the manifolds pool last slot
-					newManifold := createManifold(bodyA, bodyB)
-					newManifold.Penetration = manifold.Penetration
-					newManifold.Normal = manifold.Normal
-					newManifold.Contacts[0] = manifold.Contacts[0]
-					newManifold.Contacts[1] = manifold.Contacts[1]
-					newManifold.ContactsCount = manifold.ContactsCount
-					newManifold.Restitution = manifold.Restitution
-					newManifold.DynamicFriction = manifold.DynamicFriction
-					newManifold.StaticFriction = manifold.StaticFriction
-				}
+		w.stats.PairsTested++
+		manifold := w.createManifold(bodyA, bodyB)
+		w.solveManifold(manifold)
+
+		if manifold.ContactsCount > 0 {
+			// Remember the contact to raise its events once every pair is solved
+			w.addContact(manifold)
+			wakeOnContact(bodyA, bodyB)
+
+			// Only create persistent manifolds for physical collision resolution
+			// Triggers still call collision callbacks but don't participate in physics resolution
+			if !bodyA.IsTrigger && !bodyB.IsTrigger {
+				// Create a new manifold with same information as previously solved manifold and add it to the manifolds pool last slot
+				newManifold := w.createManifold(bodyA, bodyB)
+				w.stats.ContactManifolds++
+				newManifold.Penetration = manifold.Penetration
+				newManifold.Normal = manifold.Normal
+				newManifold.Contacts[0] = manifold.Contacts[0]
+				newManifold.Contacts[1] = manifold.Contacts[1]
+				newManifold.ContactsCount = manifold.ContactsCount
+				newManifold.Restitution = manifold.Restitution
+				newManifold.DynamicFriction = manifold.DynamicFriction
+				newManifold.StaticFriction = manifold.StaticFriction
 			}
 		}
 	}
 
+	// Forget the pairs that are not crossing one-way bodies anymore
+	w.swapOneWayCrossing()
+
+	// Add the trigger contacts crossed by continuous collision bodies on the previous step
+	w.addSweptContacts()
+
+	// Raise enter, stay and exit events comparing with the previous step contacts
+	w.dispatchContacts()
+
+	// Add spring joints forces before integrating them
+	w.applySpringForces()
+
 	// Integrate forces to physics bodies
-	for i := 0; i < bodiesCount; i++ {
-		if body := bodies[i]; body != nil && !body.Paused {
-			integrateForces(body)
+	for i := 0; i < len(w.bodies); i++ {
+		if body := w.bodies[i]; body != nil && !body.Paused {
+			w.integrateForces(body)
 		}
 	}
 
 	// Initialize physics manifolds to solve collisions
-	for i := 0; i < manifoldsCount; i++ {
-		if manifold := manifolds[i]; manifold != nil {
-			initializeManifolds(manifold)
+	for i := 0; i < len(w.manifolds); i++ {
+		if manifold := w.manifolds[i]; manifold != nil {
+			w.initializeManifolds(manifold)
 		}
 	}
 
-	// Integrate physics collisions impulses to solve collisions
+	// Integrate physics collisions and joints impulses to solve collisions and constraints
+	solverStart := time.Now()
 	for i := 0; i < collisionIterations; i++ {
-		for j := 0; j < manifoldsCount; j++ {
-			if manifold := manifolds[j]; manifold != nil {
+		for j := 0; j < len(w.manifolds); j++ {
+			if manifold := w.manifolds[j]; manifold != nil {
 				integrateImpulses(manifold)
 			}
 		}
+		w.solveJoints()
 	}
+	w.stats.SolverTime += time.Since(solverStart)
 
 	// Integrate velocity to physics bodies
-	for i := 0; i < bodiesCount; i++ {
-		if body := bodies[i]; body != nil && !body.Paused {
-			integrateVelocity(body)
+	for i := 0; i < len(w.bodies); i++ {
+		if body := w.bodies[i]; body != nil && !body.Paused {
+			body.sweepStart = body.Position
+			w.integrateVelocity(body)
 		}
 	}
 
+	// Sweep continuous collision bodies so they don't tunnel through thin bodies
+	w.sweepBodies()
+
 	// Correct physics bodies positions based on manifolds collision information
-	for i := 0; i < manifoldsCount; i++ {
-		if manifold := manifolds[i]; manifold != nil {
+	for i := 0; i < len(w.manifolds); i++ {
+		if manifold := w.manifolds[i]; manifold != nil {
 			correctPositions(manifold)
 		}
 	}
 
-	// Clear physics bodies forces
-	for i := 0; i < bodiesCount; i++ {
-		if body := bodies[i]; body != nil && !body.Paused {
+	// Put to sleep the islands of resting bodies
+	w.updateSleeping()
+
+	// Clear physics bodies forces and reached kinematic targets
+	for i := 0; i < len(w.bodies); i++ {
+		if body := w.bodies[i]; body != nil && !body.Paused {
 			body.Force = rl.Vector2{}
 			body.Torque = 0
+			if body.hasTarget {
+				body.hasTarget = false
+				body.Velocity = rl.Vector2{}
+			}
 		}
 	}
-}
-
-// Update - Runs physics step
-func Update() {
-	// Calculate current time
-	currentTime = getCurrentTime()
 
-	// Calculate current delta time
-	var delta float32 = currentTime - startTime
+	w.stats.Steps++
+	w.stats.StepTime += time.Since(stepStart)
+}
 
-	// Store the time elapsed since the last frame began
-	accumulator += delta
+// Update - Advances the simulation by dt seconds, running as many fixed steps as fit in the
+// accumulated time. Returns the number of steps taken
+func (w *World) Update(dt float32) int {
+	// Store the time elapsed since the last update
+	w.accumulator += dt
 
 	// Fixed time stepping loop
-	for accumulator >= deltaTime {
-		step()
-		accumulator -= deltaTime
-	}
+	w.resetStats()
+	steps := 0
+	for w.accumulator >= w.deltaTime {
+		w.step()
+		w.accumulator -= w.deltaTime
+		steps++
+	}
+	w.countBodies()
+	return steps
+}
 
-	// Record the starting of this frame
-	startTime = currentTime
+// Step - Runs exactly the given amount of fixed steps, leaving the accumulator untouched
+func (w *World) Step(steps int) {
+	w.resetStats()
+	for i := 0; i < steps; i++ {
+		w.step()
+	}
+	w.countBodies()
 }
 
 // SetTimeStep - Sets physics fixed time step in secconds. 1.666666 / 1000 by default
-func SetTimeStep(delta float32) {
-	deltaTime = delta
+func (w *World) SetTimeStep(delta float32) {
+	w.deltaTime = delta
 }
 
-// findAvailableManifoldIndex - Finds a valid index for a new manifold initialization
-func findAvailableManifoldIndex() int {
-	index := -1
-	for i := 0; i < maxManifolds; i++ {
-		var currentId int = i
-
-		// Check if current id already exist in other physics body
-		for k := 0; k < manifoldsCount; k++ {
-			if manifolds[k].ID == currentId {
-				currentId++
-				break
-			}
-		}
-
-		// If it is not used, use it as new physics body id
-		if currentId == i {
-			index = i
-			break
-		}
-	}
-	return index
+// GetTimeStep - Returns the physics fixed time step in seconds
+func (w *World) GetTimeStep() float32 {
+	return w.deltaTime
 }
 
 // createManifold - Creates a new physics manifold to solve collision
-func createManifold(a *Body, b *Body) *Manifold {
-	newID := findAvailableManifoldIndex()
-	if newID < 0 {
-		return nil
-	}
+func (w *World) createManifold(a *Body, b *Body) *Manifold {
+	newID := len(w.manifolds)
 
 	// Initialize new manifold with generic values
 	newManifold := &Manifold{
@@ -922,46 +1188,21 @@ func createManifold(a *Body, b *Body) *Manifold {
 		StaticFriction:  0.0,
 	}
 
-	// Add new contact to conctas pointers array and update contacts count
-	manifolds[manifoldsCount] = newManifold
-	manifoldsCount++
+	// Add new contact to contacts pointers slice
+	w.manifolds = append(w.manifolds, newManifold)
+	w.stats.ManifoldsCreated++
 
 	return newManifold
 }
 
-// destroyManifold - Unitializes and destroys a physics manifold
-func destroyManifold(manifold *Manifold) {
-	if manifold == nil {
-		return
-	}
-
-	id := manifold.ID
-	index := -1
-	for i := 0; i < manifoldsCount; i++ {
-		if manifolds[i].ID == id {
-			index = i
-			break
-		}
-	}
-	if index < 0 {
-		return
-	}
-
-	manifolds[index] = nil
-
-	// Reorder physics manifolds pointers array and its catched index
-	for i := index; i < manifoldsCount; i++ {
-		if (i + 1) < manifoldsCount {
-			manifolds[i] = manifolds[i+1]
-		}
-	}
-
-	// Update physics manifolds count
-	manifoldsCount--
+// clearManifolds - Unitializes and destroys every physics manifold
+func (w *World) clearManifolds() {
+	clear(w.manifolds)
+	w.manifolds = w.manifolds[:0]
 }
 
 // solveManifold - Solves a created physics manifold between two physics bodies
-func solveManifold(manifold *Manifold) {
+func (w *World) solveManifold(manifold *Manifold) {
 	if manifold == nil || manifold.BodyA == nil || manifold.BodyB == nil {
 		return
 	}
@@ -969,6 +1210,22 @@ func solveManifold(manifold *Manifold) {
 	if manifold.BodyA.Paused || manifold.BodyB.Paused {
 		return
 	}
+	solveShapes(manifold)
+
+	// One-way bodies crossed from the wrong side don't collide
+	if w.discardOneWay(manifold) {
+		return
+	}
+
+	// Update physics body grounded state if normal direction is down and grounded state
+	// is not set yet in previous manifolds
+	if !manifold.BodyB.IsGrounded {
+		manifold.BodyB.IsGrounded = manifold.Normal.Y < 0
+	}
+}
+
+// solveShapes - Computes the contact information between the shapes of the manifold bodies
+func solveShapes(manifold *Manifold) {
 	switch manifold.BodyA.Shape.Type {
 	case CircleShape:
 		switch manifold.BodyB.Shape.Type {
@@ -985,25 +1242,6 @@ func solveManifold(manifold *Manifold) {
 			solvePolygonToPolygon(manifold)
 		}
 	}
-
-	// Update physics body grounded state if normal direction is down and grounded state
-	// is not set yet in previous manifolds
-	if !manifold.BodyB.IsGrounded {
-		manifold.BodyB.IsGrounded = manifold.Normal.Y < 0
-	}
-
-	// If the collision is real (there are contact points), trigger the callbacks.
-	if manifold.ContactsCount > 0 {
-		// Call BodyA's callback, if it exists, passing BodyB
-		if manifold.BodyA.OnCollision != nil && !manifold.BodyA.Paused && !manifold.BodyB.Paused {
-			manifold.BodyA.OnCollision(manifold.BodyB, manifold)
-		}
-
-		// Call BodyB's callback, if it exists, passing BodyA
-		if manifold.BodyB.OnCollision != nil && !manifold.BodyA.Paused && !manifold.BodyB.Paused {
-			manifold.BodyB.OnCollision(manifold.BodyA, manifold)
-		}
-	}
 }
 
 // solveCircleToCircle - Solves collision between two circle shape physics bodies
@@ -1285,26 +1523,52 @@ func solvePolygonToPolygon(manifold *Manifold) {
 }
 
 // integrateForces - Integrates physics forces into velocity
-func integrateForces(body *Body) {
-	if body == nil || body.InverseMass == 0 || !body.Enabled || body.IsTrigger || body.Paused {
+func (w *World) integrateForces(body *Body) {
+	if body == nil || body.InverseMass == 0 || !body.Enabled || body.Paused || body.IsSleeping {
 		return
 	}
 
-	body.Velocity.X += body.Force.X * body.InverseMass * (deltaTime / 2.0)
-	body.Velocity.Y += body.Force.Y * body.InverseMass * (deltaTime / 2.0)
+	body.Velocity.X += body.Force.X * body.InverseMass * (w.deltaTime / 2.0)
+	body.Velocity.Y += body.Force.Y * body.InverseMass * (w.deltaTime / 2.0)
 
 	if body.UseGravity {
-		body.Velocity.X += gravityForce.X * (deltaTime / 2.0)
-		body.Velocity.Y += gravityForce.Y * (deltaTime / 2.0)
+		body.Velocity.X += w.gravityForce.X * body.GravityScale * (w.deltaTime / 2.0)
+		body.Velocity.Y += w.gravityForce.Y * body.GravityScale * (w.deltaTime / 2.0)
 	}
 
 	if !body.FreezeOrient {
-		body.AngularVelocity += body.Torque * body.InverseInertia * (deltaTime / 2.0)
+		body.AngularVelocity += body.Torque * body.InverseInertia * (w.deltaTime / 2.0)
+	}
+
+	// Damping is applied as 1/(1 + dt*damping), stable for any time step
+	if body.LinearDamping > 0 {
+		body.Velocity = rl.Vector2Scale(body.Velocity, 1.0/(1.0+body.LinearDamping*(w.deltaTime/2.0)))
+	}
+	if body.AngularDamping > 0 {
+		body.AngularVelocity /= 1.0 + body.AngularDamping*(w.deltaTime/2.0)
+	}
+
+	limitSpeed(body)
+}
+
+// limitSpeed - Scales down the body velocity when it is over the maximum speed, then clamps
+// each axis to the maximum velocity
+func limitSpeed(body *Body) {
+	if body.MaxSpeed > 0 {
+		if speedSqr := rl.Vector2LenSqr(body.Velocity); speedSqr > body.MaxSpeed*body.MaxSpeed {
+			body.Velocity = rl.Vector2Scale(body.Velocity, body.MaxSpeed/float32(math.Sqrt(float64(speedSqr))))
+		}
+	}
+	if body.MaxVelocity.X > 0 {
+		body.Velocity.X = rl.Clamp(body.Velocity.X, -body.MaxVelocity.X, body.MaxVelocity.X)
+	}
+	if body.MaxVelocity.Y > 0 {
+		body.Velocity.Y = rl.Clamp(body.Velocity.Y, -body.MaxVelocity.Y, body.MaxVelocity.Y)
 	}
 }
 
 // initializeManifolds - Initializes physics manifolds to solve collisions
-func initializeManifolds(manifold *Manifold) {
+func (w *World) initializeManifolds(manifold *Manifold) {
 	bodyA, bodyB := manifold.BodyA, manifold.BodyB
 
 	if bodyA == nil || bodyB == nil {
@@ -1314,10 +1578,8 @@ func initializeManifolds(manifold *Manifold) {
 		return
 	}
 
-	// // Calculate average restitution, static and dynamic friction
-	manifold.Restitution = float32(math.Sqrt(float64(bodyA.Restitution * bodyB.Restitution)))
-	manifold.StaticFriction = float32(math.Sqrt(float64(bodyA.StaticFriction * bodyB.StaticFriction)))
-	manifold.DynamicFriction = float32(math.Sqrt(float64(bodyA.DynamicFriction * bodyB.DynamicFriction)))
+	// Calculate restitution, static and dynamic friction from the materials pair table or the bodies
+	w.mixMaterials(manifold)
 
 	for i := 0; i < manifold.ContactsCount; i++ {
 		// Caculate radius from center of mass to contact
@@ -1334,7 +1596,7 @@ func initializeManifolds(manifold *Manifold) {
 		// Determine if we should perform a resting collision or not;
 		// The idea is if the only thing moving this object is gravity, then the collision should be
 		// performed without any restitution
-		rd := rl.NewVector2(gravityForce.X*deltaTime, gravityForce.Y*deltaTime)
+		rd := rl.NewVector2(w.gravityForce.X*w.deltaTime, w.gravityForce.Y*w.deltaTime)
 		// Use seconds-based gravity threshold
 		if rl.Vector2LenSqr(radiusV) < (rl.Vector2LenSqr(rd) + epsilon) {
 			manifold.Restitution = 0
@@ -1476,25 +1738,25 @@ func integrateImpulses(manifold *Manifold) {
 }
 
 // integrateVelocity - Integrates physics velocity into position and forces
-func integrateVelocity(body *Body) {
-	if body == nil || !body.Enabled || body.Paused {
+func (w *World) integrateVelocity(body *Body) {
+	if body == nil || !body.Enabled || body.Paused || body.IsSleeping || body.Type == StaticBody {
 		return
 	}
 
+	// Impulses may have pushed the body over its maximum speed or velocity
+	limitSpeed(body)
+
 	// deltaTime now in seconds (normalized); velocities already accumulated in seconds
-	body.Position.X += body.Velocity.X * deltaTime
-	body.Position.Y += body.Velocity.Y * deltaTime
+	body.Position.X += body.Velocity.X * w.deltaTime
+	body.Position.Y += body.Velocity.Y * w.deltaTime
 
 	if !body.FreezeOrient {
-		body.Orient += body.AngularVelocity * deltaTime
+		body.Orient += body.AngularVelocity * w.deltaTime
 	}
 
 	rl.Mat2Set(&body.Shape.Transform, body.Orient)
 
-	// Only integrate forces for non-trigger bodies
-	if !body.IsTrigger {
-		integrateForces(body)
-	}
+	w.integrateForces(body)
 }
 
 // correctPositions - Corrects physics bodies positions based on manifolds collision information
@@ -1662,23 +1924,6 @@ func triangleBarycenter(v1 rl.Vector2, v2 rl.Vector2, v3 rl.Vector2) rl.Vector2
 	)
 }
 
-// initTimer - Initializes hi-resolution MONOTONIC timer
-func initTimer() {
-	rand.Seed(getTimeCount())
-	frequency = 1000000000
-	startTime = getCurrentTime() // Get current time
-}
-
-// getTimeCount - Gets hi-res MONOTONIC time measure in nanoseconds
-func getTimeCount() int64 {
-	return time.Since(baseTime).Nanoseconds()
-}
-
-// getCurrentTime - Gets current time measure in seconds
-func getCurrentTime() float32 {
-	return float32(getTimeCount()) / float32(frequency)
-}
-
 // normalize - Returns the normalized values of a vector
 func normalize(vector *rl.Vector2) {
 	aux := *vector
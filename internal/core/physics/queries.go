@@ -0,0 +1,208 @@
+package physics
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// RaycastHit type
+type RaycastHit struct {
+	// Physics body hit by the ray
+	Body *Body
+	// World space point where the ray enters the body shape
+	Point rl.Vector2
+	// Surface normal at the hit point
+	Normal rl.Vector2
+	// Distance from the ray origin to the hit point
+	Distance float32
+}
+
+// Raycast - Casts a segment from 'from' to 'to' and returns the closest body hit whose
+// category matches the mask. Rays starting inside a body hit it at distance 0. Disabled,
+// paused and destroyed bodies are skipped, like in the overlap queries
+func (w *World) Raycast(from, to rl.Vector2, mask CollisionLayer) (RaycastHit, bool) {
+	var result RaycastHit
+	found := false
+	direction := rl.Vector2Subtract(to, from)
+	length := rl.Vector2Length(direction)
+	segment := AABB{
+		Min: rl.NewVector2(min(from.X, to.X), min(from.Y, to.Y)),
+		Max: rl.NewVector2(max(from.X, to.X), max(from.Y, to.Y)),
+	}
+	bestFraction := float32(math.MaxFloat32)
+
+	for _, body := range w.bodies {
+		if body == nil || !body.Enabled || body.Paused || body.destroyed || body.Category&mask == 0 {
+			continue
+		}
+		if !segment.Overlaps(body.GetAABB()) {
+			continue
+		}
+
+		var fraction float32
+		var normal rl.Vector2
+		var hit bool
+		switch body.Shape.Type {
+		case CircleShape:
+			fraction, normal, hit = raycastCircle(body, from, direction)
+		case PolygonShape:
+			fraction, normal, hit = raycastPolygon(body, from, direction)
+		}
+		if !hit || fraction >= bestFraction {
+			continue
+		}
+
+		bestFraction = fraction
+		found = true
+		result = RaycastHit{
+			Body:     body,
+			Point:    rl.NewVector2(from.X+direction.X*fraction, from.Y+direction.Y*fraction),
+			Normal:   normal,
+			Distance: fraction * length,
+		}
+	}
+
+	return result, found
+}
+
+// OverlapRect - Returns the bodies whose category matches the mask and overlap an axis
+// aligned rectangle centered at a position
+func (w *World) OverlapRect(center rl.Vector2, width, height float32, mask CollisionLayer) []*Body {
+	query := &Body{
+		Position: center,
+		Shape: Shape{
+			Type:       PolygonShape,
+			Transform:  rl.Mat2Radians(0),
+			VertexData: createRectanglePolygon(rl.NewVector2(width, height)),
+		},
+	}
+	query.Shape.Body = query
+	return w.overlap(query, mask)
+}
+
+// OverlapCircle - Returns the bodies whose category matches the mask and overlap a circle
+func (w *World) OverlapCircle(center rl.Vector2, radius float32, mask CollisionLayer) []*Body {
+	query := &Body{
+		Position: center,
+		Shape: Shape{
+			Type:      CircleShape,
+			Radius:    radius,
+			Transform: rl.Mat2Radians(0),
+		},
+	}
+	query.Shape.Body = query
+	return w.overlap(query, mask)
+}
+
+// overlap - Returns the bodies touching the shape of a query body that is not part of the world
+func (w *World) overlap(query *Body, mask CollisionLayer) []*Body {
+	var result []*Body
+	box := query.GetAABB()
+
+	for _, body := range w.bodies {
+		if body == nil || !body.Enabled || body.Paused || body.destroyed || body.Category&mask == 0 {
+			continue
+		}
+		if !box.Overlaps(body.GetAABB()) {
+			continue
+		}
+
+		// Reuse the narrowphase with the query shape as the first body
+		manifold := Manifold{BodyA: query, BodyB: body}
+		solveShapes(&manifold)
+		if manifold.ContactsCount > 0 {
+			result = append(result, body)
+		}
+	}
+
+	return result
+}
+
+// raycastCircle - Intersects a ray with a circle shape body, returning the fraction of the
+// ray direction where it enters the circle and the surface normal there
+func raycastCircle(body *Body, from rl.Vector2, direction rl.Vector2) (float32, rl.Vector2, bool) {
+	offset := rl.Vector2Subtract(from, body.Position)
+	radius := body.Shape.Radius
+	c := rl.Vector2DotProduct(offset, offset) - radius*radius
+
+	// Ray starts inside the circle
+	if c <= 0 {
+		return 0, reversed(direction), true
+	}
+
+	a := rl.Vector2DotProduct(direction, direction)
+	if a <= epsilon {
+		return 0, rl.Vector2{}, false
+	}
+	b := rl.Vector2DotProduct(offset, direction)
+	discriminant := b*b - a*c
+	if discriminant < 0 {
+		return 0, rl.Vector2{}, false
+	}
+
+	fraction := (-b - float32(math.Sqrt(float64(discriminant)))) / a
+	if fraction < 0 || fraction > 1 {
+		return 0, rl.Vector2{}, false
+	}
+
+	normal := rl.NewVector2(offset.X+direction.X*fraction, offset.Y+direction.Y*fraction)
+	normalize(&normal)
+	return fraction, normal, true
+}
+
+// raycastPolygon - Intersects a ray with a convex polygon shape body clipping it against
+// every face plane, returning the fraction of the ray direction where it enters the polygon
+// and the normal of the entering face
+func raycastPolygon(body *Body, from rl.Vector2, direction rl.Vector2) (float32, rl.Vector2, bool) {
+	vertexData := body.Shape.VertexData
+	enter := float32(0)
+	exit := float32(1)
+	var enterNormal rl.Vector2
+	entered := false
+
+	for i := 0; i < vertexData.VertexCount; i++ {
+		normal := rl.Mat2MultiplyVector2(body.Shape.Transform, vertexData.Normals[i])
+		vertex := body.GetShapeVertex(i)
+
+		numerator := rl.Vector2DotProduct(normal, rl.Vector2Subtract(vertex, from))
+		denominator := rl.Vector2DotProduct(normal, direction)
+
+		if denominator == 0 {
+			// Parallel to the face and outside of it
+			if numerator < 0 {
+				return 0, rl.Vector2{}, false
+			}
+			continue
+		}
+
+		fraction := numerator / denominator
+		if denominator < 0 {
+			if fraction > enter {
+				enter = fraction
+				enterNormal = normal
+				entered = true
+			}
+		} else if fraction < exit {
+			exit = fraction
+		}
+
+		if enter > exit {
+			return 0, rl.Vector2{}, false
+		}
+	}
+
+	// Ray starts inside the polygon
+	if !entered {
+		return 0, reversed(direction), true
+	}
+
+	return enter, enterNormal, true
+}
+
+// reversed - Returns the normalized opposite of a vector
+func reversed(vector rl.Vector2) rl.Vector2 {
+	result := rl.NewVector2(-vector.X, -vector.Y)
+	normalize(&result)
+	return result
+}
@@ -0,0 +1,160 @@
+package physics
+
+import (
+	"slices"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Query test collision layers
+const (
+	queryLayerA CollisionLayer = 1 << 1
+	queryLayerB CollisionLayer = 1 << 2
+)
+
+// vectorsNear - Reports whether two vectors are equal within a small tolerance
+func vectorsNear(a, b rl.Vector2) bool {
+	return rl.Vector2Distance(a, b) < 1e-3
+}
+
+// newQueryWorld - Creates a world without gravity holding a circle of radius 10 at (100, 0)
+// in layer A and a 20x20 rectangle at (100, 100) in layer B
+func newQueryWorld(t *testing.T) (*World, *Body, *Body) {
+	t.Helper()
+	w := NewWorld()
+	w.SetGravity(0, 0)
+	circle, err := w.NewBodyCircle("circle", rl.NewVector2(100, 0), 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	circle.SetCollisionFilter(queryLayerA, LayerAll)
+	rectangle, err := w.NewBodyRectangle("rectangle", rl.NewVector2(100, 100), 20, 20, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rectangle.SetCollisionFilter(queryLayerB, LayerAll)
+	return w, circle, rectangle
+}
+
+func TestRaycast(t *testing.T) {
+	w, circle, rectangle := newQueryWorld(t)
+	tests := []struct {
+		name     string
+		from, to rl.Vector2
+		mask     CollisionLayer
+		want     RaycastHit
+		hit      bool
+	}{
+		{
+			name: "circle", from: rl.NewVector2(0, 0), to: rl.NewVector2(200, 0), mask: LayerAll,
+			want: RaycastHit{Body: circle, Point: rl.NewVector2(90, 0), Normal: rl.NewVector2(-1, 0), Distance: 90},
+			hit:  true,
+		},
+		{
+			name: "rectangle", from: rl.NewVector2(100, 200), to: rl.NewVector2(100, 50), mask: LayerAll,
+			want: RaycastHit{Body: rectangle, Point: rl.NewVector2(100, 110), Normal: rl.NewVector2(0, 1), Distance: 90},
+			hit:  true,
+		},
+		{
+			name: "closest of two bodies", from: rl.NewVector2(100, -50), to: rl.NewVector2(100, 200), mask: LayerAll,
+			want: RaycastHit{Body: circle, Point: rl.NewVector2(100, -10), Normal: rl.NewVector2(0, -1), Distance: 40},
+			hit:  true,
+		},
+		{
+			name: "behind the masked body", from: rl.NewVector2(100, -50), to: rl.NewVector2(100, 200), mask: queryLayerB,
+			want: RaycastHit{Body: rectangle, Point: rl.NewVector2(100, 90), Normal: rl.NewVector2(0, -1), Distance: 140},
+			hit:  true,
+		},
+		{
+			name: "starting inside", from: rl.NewVector2(105, 0), to: rl.NewVector2(200, 0), mask: LayerAll,
+			want: RaycastHit{Body: circle, Point: rl.NewVector2(105, 0), Normal: rl.NewVector2(-1, 0), Distance: 0},
+			hit:  true,
+		},
+		{name: "passing by", from: rl.NewVector2(0, 50), to: rl.NewVector2(200, 50), mask: LayerAll},
+		{name: "too short", from: rl.NewVector2(0, 0), to: rl.NewVector2(80, 0), mask: LayerAll},
+		{name: "masked out", from: rl.NewVector2(0, 0), to: rl.NewVector2(200, 0), mask: queryLayerB},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, hit := w.Raycast(tt.from, tt.to, tt.mask)
+			if hit != tt.hit {
+				t.Fatalf("hit = %v, want %v", hit, tt.hit)
+			}
+			if !hit {
+				return
+			}
+			if got.Body != tt.want.Body || !vectorsNear(got.Point, tt.want.Point) ||
+				!vectorsNear(got.Normal, tt.want.Normal) || !near(got.Distance, tt.want.Distance, 1e-4) {
+				t.Errorf("hit %s at %v, normal %v, distance %v, want %s at %v, normal %v, distance %v",
+					got.Body.Tag, got.Point, got.Normal, got.Distance,
+					tt.want.Body.Tag, tt.want.Point, tt.want.Normal, tt.want.Distance)
+			}
+		})
+	}
+}
+
+func TestOverlapQueries(t *testing.T) {
+	w, circle, rectangle := newQueryWorld(t)
+	tests := []struct {
+		name  string
+		query func() []*Body
+		want  []*Body
+	}{
+		{"rect over both", func() []*Body { return w.OverlapRect(rl.NewVector2(100, 50), 10, 100, LayerAll) }, []*Body{circle, rectangle}},
+		{"rect over the circle", func() []*Body { return w.OverlapRect(rl.NewVector2(85, 0), 20, 20, LayerAll) }, []*Body{circle}},
+		{"rect in between", func() []*Body { return w.OverlapRect(rl.NewVector2(100, 50), 50, 50, LayerAll) }, nil},
+		{"rect masked", func() []*Body { return w.OverlapRect(rl.NewVector2(100, 50), 10, 100, queryLayerB) }, []*Body{rectangle}},
+		{"circle over both", func() []*Body { return w.OverlapCircle(rl.NewVector2(100, 50), 45, LayerAll) }, []*Body{circle, rectangle}},
+		{"circle over the rectangle corner", func() []*Body { return w.OverlapCircle(rl.NewVector2(115, 115), 8, LayerAll) }, []*Body{rectangle}},
+		{"circle next to the rectangle corner", func() []*Body { return w.OverlapCircle(rl.NewVector2(118, 118), 8, LayerAll) }, nil},
+		{"circle masked", func() []*Body { return w.OverlapCircle(rl.NewVector2(100, 50), 45, queryLayerA) }, []*Body{circle}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query(); !slices.Equal(got, tt.want) {
+				t.Errorf("overlapping %v, want %v", tags(got), tags(tt.want))
+			}
+		})
+	}
+}
+
+func TestQueriesSkipInactiveBodies(t *testing.T) {
+	tests := []struct {
+		name string
+		// deactivate makes the circle inactive while the queries run
+		deactivate func(w *World, body *Body)
+	}{
+		{"disabled", func(w *World, body *Body) { body.Enabled = false }},
+		{"paused", func(w *World, body *Body) { body.Paused = true }},
+		{"destroyed", func(w *World, body *Body) { body.Destroy() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, circle, _ := newQueryWorld(t)
+			// Destroyed bodies stay in a locked world until it is unlocked
+			w.Lock()
+			defer w.Unlock()
+			tt.deactivate(w, circle)
+
+			if hit, found := w.Raycast(rl.NewVector2(0, 0), rl.NewVector2(200, 0), LayerAll); found {
+				t.Errorf("Raycast hit %s", hit.Body.Tag)
+			}
+			if got := w.OverlapRect(circle.Position, 10, 10, LayerAll); len(got) > 0 {
+				t.Errorf("OverlapRect found %v", tags(got))
+			}
+			if got := w.OverlapCircle(circle.Position, 10, LayerAll); len(got) > 0 {
+				t.Errorf("OverlapCircle found %v", tags(got))
+			}
+		})
+	}
+}
+
+// tags - Returns the tags of bodies, to report them in failures
+func tags(bodies []*Body) []string {
+	var result []string
+	for _, body := range bodies {
+		result = append(result, body.Tag)
+	}
+	return result
+}
@@ -0,0 +1,148 @@
+package physics
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Sleeping constants
+const (
+	// Linear speed below which a body is considered at rest, in pixels per second
+	sleepLinearTolerance = 5.0
+	// Angular speed below which a body is considered at rest, in radians per second
+	sleepAngularTolerance = 0.05
+	// Time a whole island must stay at rest before falling asleep, in seconds
+	timeToSleep = 0.5
+)
+
+// SetSleeping - Enables or disables putting resting bodies to sleep. Disabling it wakes up
+// every sleeping body
+func (w *World) SetSleeping(enabled bool) {
+	w.disableSleeping = !enabled
+	if !enabled {
+		for _, body := range w.bodies {
+			body.WakeUp()
+		}
+	}
+}
+
+// WakeUp - Wakes up a sleeping body, the bodies touching it wake up on the next step
+func (b *Body) WakeUp() {
+	b.IsSleeping = false
+	b.sleepTime = 0
+}
+
+// isAwake - Checks if a body can move on its own during the step
+func (b *Body) isAwake() bool {
+	return !b.IsSleeping && b.Type != StaticBody
+}
+
+// wakeOnContact - Wakes up a sleeping body touched by an awake body that can push it
+func wakeOnContact(bodyA *Body, bodyB *Body) {
+	if bodyA.IsTrigger || bodyB.IsTrigger {
+		return
+	}
+	if bodyA.IsSleeping && bodyB.isAwake() {
+		bodyA.WakeUp()
+	}
+	if bodyB.IsSleeping && bodyA.isAwake() {
+		bodyB.WakeUp()
+	}
+}
+
+// wakeJointedBodies - Wakes up the sleeping bodies linked by a joint to an awake body
+func (w *World) wakeJointedBodies() {
+	for _, joint := range w.joints {
+		if !joint.isActive() || joint.BodyB == nil {
+			continue
+		}
+		if joint.BodyA.IsSleeping && joint.BodyB.isAwake() {
+			joint.BodyA.WakeUp()
+		}
+		if joint.BodyB.IsSleeping && joint.BodyA.isAwake() {
+			joint.BodyB.WakeUp()
+		}
+	}
+}
+
+// updateSleeping - Accumulates the resting time of the awake dynamic bodies and puts to sleep
+// the islands of bodies, linked by contacts or joints, that have all been resting long enough
+func (w *World) updateSleeping() {
+	if w.disableSleeping {
+		return
+	}
+
+	// Each body starts as its own island
+	w.islands = w.islands[:0]
+	for i, body := range w.bodies {
+		body.islandIndex = i
+		w.islands = append(w.islands, i)
+
+		if body.Type != DynamicBody || body.Paused || body.IsSleeping {
+			continue
+		}
+		if !body.AllowSleep ||
+			rl.Vector2LenSqr(body.Velocity) > sleepLinearTolerance*sleepLinearTolerance ||
+			body.AngularVelocity*body.AngularVelocity > sleepAngularTolerance*sleepAngularTolerance {
+			body.sleepTime = 0
+		} else {
+			body.sleepTime += w.deltaTime
+		}
+	}
+
+	// Merge the islands of dynamic bodies touching or linked together
+	for _, manifold := range w.manifolds {
+		if manifold.ContactsCount == 0 || manifold.BodyA.IsTrigger || manifold.BodyB.IsTrigger {
+			continue
+		}
+		w.mergeIslands(manifold.BodyA, manifold.BodyB)
+	}
+	for _, joint := range w.joints {
+		if joint.isActive() && joint.BodyB != nil {
+			w.mergeIslands(joint.BodyA, joint.BodyB)
+		}
+	}
+
+	// An island sleeps only when every body in it can
+	w.islandSleepTimes = w.islandSleepTimes[:0]
+	for range w.bodies {
+		w.islandSleepTimes = append(w.islandSleepTimes, timeToSleep)
+	}
+	for i, body := range w.bodies {
+		if body.Type != DynamicBody || body.Paused || body.IsSleeping {
+			continue
+		}
+		root := w.findIsland(i)
+		w.islandSleepTimes[root] = min(w.islandSleepTimes[root], body.sleepTime)
+	}
+	for i, body := range w.bodies {
+		if body.Type != DynamicBody || body.Paused || body.IsSleeping {
+			continue
+		}
+		if w.islandSleepTimes[w.findIsland(i)] >= timeToSleep {
+			body.IsSleeping = true
+			body.Velocity = rl.Vector2{}
+			body.AngularVelocity = 0
+		}
+	}
+}
+
+// mergeIslands - Joins the islands of two dynamic bodies
+func (w *World) mergeIslands(bodyA *Body, bodyB *Body) {
+	if bodyA.Type != DynamicBody || bodyB.Type != DynamicBody {
+		return
+	}
+	rootA, rootB := w.findIsland(bodyA.islandIndex), w.findIsland(bodyB.islandIndex)
+	if rootA != rootB {
+		w.islands[rootB] = rootA
+	}
+}
+
+// findIsland - Returns the root body index of the island of a body index
+func (w *World) findIsland(index int) int {
+	for w.islands[index] != index {
+		// Path halving keeps the trees flat
+		w.islands[index] = w.islands[w.islands[index]]
+		index = w.islands[index]
+	}
+	return index
+}
@@ -0,0 +1,74 @@
+package physics
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// stepSeconds - Runs the fixed steps covering a duration in seconds
+func stepSeconds(w *World, seconds float32) {
+	w.Step(int(seconds / w.GetTimeStep()))
+}
+
+func TestRestingSpringPairFallsAsleep(t *testing.T) {
+	tests := []struct {
+		name    string
+		gravity float32
+	}{
+		{"at rest length", 0},
+		{"hanging under gravity", 800},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld()
+			w.SetGravity(0, tt.gravity)
+			top, err := w.NewBodyCircle("top", rl.NewVector2(100, 100), 10, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bottom, err := w.NewBodyCircle("bottom", rl.NewVector2(100, 150), 10, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Stiff springs, critically damped, so the pair settles quickly
+			stiffness := top.Mass * 100
+			damping := 2 * float32(math.Sqrt(float64(stiffness*top.Mass)))
+			// The top body hangs from the world, the bottom one from the top body
+			if _, err := w.NewSpringJoint(top, nil, top.Position, rl.NewVector2(100, 50), stiffness, damping); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := w.NewSpringJoint(bottom, top, bottom.Position, top.Position, stiffness, damping); err != nil {
+				t.Fatal(err)
+			}
+
+			stepSeconds(w, 20)
+			if !top.IsSleeping || !bottom.IsSleeping {
+				t.Errorf(
+					"resting spring pair is awake: top sleeping %v velocity %v, bottom sleeping %v velocity %v",
+					top.IsSleeping, top.Velocity, bottom.IsSleeping, bottom.Velocity,
+				)
+			}
+		})
+	}
+}
+
+func TestAddForceWakesOnlyOnNonZeroForce(t *testing.T) {
+	w := NewWorld()
+	body, err := w.NewBodyCircle("body", rl.NewVector2(100, 100), 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body.IsSleeping = true
+
+	AddForce(body, rl.Vector2{})
+	AddTorque(body, 0)
+	if !body.IsSleeping {
+		t.Fatal("zero force woke the body up")
+	}
+	AddForce(body, rl.NewVector2(10, 0))
+	if body.IsSleeping {
+		t.Fatal("non-zero force did not wake the body up")
+	}
+}
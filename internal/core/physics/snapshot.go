@@ -0,0 +1,572 @@
+package physics
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Snapshot constants
+const (
+	// Version of the snapshot layout, bumped whenever an encoded field changes
+	snapshotVersion = 6
+)
+
+// Snapshot errors
+var (
+	// ErrWorldLocked is returned when the world can't be modified because it is stepping
+	ErrWorldLocked = errors.New("physics: world is locked")
+	// ErrInvalidSnapshot is returned when a snapshot can't be restored or decoded
+	ErrInvalidSnapshot = errors.New("physics: invalid snapshot")
+)
+
+// Snapshot type, full state of a physics world at a given time. Body callbacks are not part
+// of the state
+type Snapshot struct {
+	// Snapshot layout version
+	Version int `json:"version"`
+	// Fixed physics step in seconds
+	DeltaTime float32 `json:"deltaTime"`
+	// Physics time step delta time accumulator
+	Accumulator float32 `json:"accumulator"`
+	// Physics world gravity force
+	Gravity rl.Vector2 `json:"gravity"`
+	// Next unique identifier handed to a new body
+	NextBodyID int `json:"nextBodyId"`
+	// Next unique identifier handed to a new joint
+	NextJointID int `json:"nextJointId"`
+	// Disables the broadphase
+	DisableBroadphase bool `json:"disableBroadphase"`
+	// Disables putting resting bodies to sleep
+	DisableSleeping bool `json:"disableSleeping"`
+	// Contact properties overriding specific material pairs, sorted by material names
+	MaterialPairs []MaterialPairState `json:"materialPairs"`
+	// Physics bodies state, in world order
+	Bodies []BodyState `json:"bodies"`
+	// Physics joints state, in world order
+	Joints []JointState `json:"joints"`
+	// Manifolds of the last step
+	Manifolds []ManifoldState `json:"manifolds"`
+	// Touching body pairs of the last step, raising exit events when they separate
+	Contacts []ContactState `json:"contacts"`
+	// Trigger contacts crossed by continuous collision bodies, raised on the next step
+	SweptContacts []ManifoldState `json:"sweptContacts"`
+	// Pairs crossing a one-way body, sorted by body identifiers
+	OneWayCrossing []ContactState `json:"oneWayCrossing"`
+}
+
+// BodyState type, mirrors the Body fields along with its kinematic target and resting time
+type BodyState struct {
+	ID                  int            `json:"id"`
+	Type                BodyType       `json:"type"`
+	Enabled             bool           `json:"enabled"`
+	Paused              bool           `json:"paused"`
+	Position            rl.Vector2     `json:"position"`
+	Velocity            rl.Vector2     `json:"velocity"`
+	Force               rl.Vector2     `json:"force"`
+	AngularVelocity     float32        `json:"angularVelocity"`
+	Torque              float32        `json:"torque"`
+	Orient              float32        `json:"orient"`
+	PreviousPosition    rl.Vector2     `json:"previousPosition"`
+	PreviousOrient      float32        `json:"previousOrient"`
+	Inertia             float32        `json:"inertia"`
+	InverseInertia      float32        `json:"inverseInertia"`
+	Mass                float32        `json:"mass"`
+	InverseMass         float32        `json:"inverseMass"`
+	StaticFriction      float32        `json:"staticFriction"`
+	DynamicFriction     float32        `json:"dynamicFriction"`
+	Restitution         float32        `json:"restitution"`
+	Material            *Material      `json:"material,omitempty"`
+	UseGravity          bool           `json:"useGravity"`
+	GravityScale        float32        `json:"gravityScale"`
+	LinearDamping       float32        `json:"linearDamping"`
+	AngularDamping      float32        `json:"angularDamping"`
+	MaxSpeed            float32        `json:"maxSpeed"`
+	MaxVelocity         rl.Vector2     `json:"maxVelocity"`
+	IsGrounded          bool           `json:"isGrounded"`
+	IsSleeping          bool           `json:"isSleeping"`
+	AllowSleep          bool           `json:"allowSleep"`
+	FreezeOrient        bool           `json:"freezeOrient"`
+	Shape               ShapeState     `json:"shape"`
+	Tag                 string         `json:"tag"`
+	IsTrigger           bool           `json:"isTrigger"`
+	OneWay              bool           `json:"oneWay"`
+	OneWayDirection     rl.Vector2     `json:"oneWayDirection"`
+	Category            CollisionLayer `json:"category"`
+	Mask                CollisionLayer `json:"mask"`
+	ContinuousCollision bool           `json:"continuousCollision"`
+	Target              rl.Vector2     `json:"target"`
+	HasTarget           bool           `json:"hasTarget"`
+	SleepTime           float32        `json:"sleepTime"`
+}
+
+// ShapeState type, mirrors the Shape fields with only the used polygon vertices
+type ShapeState struct {
+	Type      ShapeType    `json:"type"`
+	Radius    float32      `json:"radius"`
+	Transform rl.Mat2      `json:"transform"`
+	Positions []rl.Vector2 `json:"positions,omitempty"`
+	Normals   []rl.Vector2 `json:"normals,omitempty"`
+}
+
+// JointState type, mirrors the Joint fields referencing bodies by identifier
+type JointState struct {
+	ID      int       `json:"id"`
+	Type    JointType `json:"type"`
+	Enabled bool      `json:"enabled"`
+	BodyA   int       `json:"bodyA"`
+	// Second body identifier, -1 when the joint is attached to a world point
+	BodyB        int        `json:"bodyB"`
+	LocalAnchorA rl.Vector2 `json:"localAnchorA"`
+	LocalAnchorB rl.Vector2 `json:"localAnchorB"`
+	Length       float32    `json:"length"`
+	Stiffness    float32    `json:"stiffness"`
+	Damping      float32    `json:"damping"`
+}
+
+// ManifoldState type, mirrors the Manifold fields referencing bodies by identifier
+type ManifoldState struct {
+	ID              int           `json:"id"`
+	BodyA           int           `json:"bodyA"`
+	BodyB           int           `json:"bodyB"`
+	Penetration     float32       `json:"penetration"`
+	Normal          rl.Vector2    `json:"normal"`
+	Contacts        [2]rl.Vector2 `json:"contacts"`
+	ContactsCount   int           `json:"contactsCount"`
+	Restitution     float32       `json:"restitution"`
+	DynamicFriction float32       `json:"dynamicFriction"`
+	StaticFriction  float32       `json:"staticFriction"`
+}
+
+// MaterialPairState type, contact properties overriding a pair of materials
+type MaterialPairState struct {
+	A          string            `json:"a"`
+	B          string            `json:"b"`
+	Properties ContactProperties `json:"properties"`
+}
+
+// ContactState type, pair of touching bodies referenced by identifier
+type ContactState struct {
+	BodyA int `json:"bodyA"`
+	BodyB int `json:"bodyB"`
+}
+
+// Snapshot - Captures the state of the world. Bodies waiting to be destroyed are left out
+func (w *World) Snapshot() *Snapshot {
+	s := &Snapshot{
+		Version:           snapshotVersion,
+		DeltaTime:         w.deltaTime,
+		Accumulator:       w.accumulator,
+		Gravity:           w.gravityForce,
+		NextBodyID:        w.nextBodyID,
+		NextJointID:       w.nextJointID,
+		DisableBroadphase: w.disableBroadphase,
+		DisableSleeping:   w.disableSleeping,
+		Bodies:            make([]BodyState, 0, len(w.bodies)),
+		Joints:            make([]JointState, 0, len(w.joints)),
+		Manifolds:         make([]ManifoldState, 0, len(w.manifolds)),
+		Contacts:          make([]ContactState, 0, len(w.contacts)),
+		SweptContacts:     make([]ManifoldState, 0, len(w.sweptContacts)),
+		MaterialPairs:     make([]MaterialPairState, 0, len(w.materialPairs)),
+		OneWayCrossing:    make([]ContactState, 0, len(w.oneWayCrossing)),
+	}
+
+	for key, properties := range w.materialPairs {
+		s.MaterialPairs = append(s.MaterialPairs, MaterialPairState{A: key.a, B: key.b, Properties: properties})
+	}
+	sort.Slice(s.MaterialPairs, func(i, j int) bool {
+		if s.MaterialPairs[i].A != s.MaterialPairs[j].A {
+			return s.MaterialPairs[i].A < s.MaterialPairs[j].A
+		}
+		return s.MaterialPairs[i].B < s.MaterialPairs[j].B
+	})
+
+	for key := range w.oneWayCrossing {
+		s.OneWayCrossing = append(s.OneWayCrossing, ContactState{BodyA: key.a, BodyB: key.b})
+	}
+	sort.Slice(s.OneWayCrossing, func(i, j int) bool {
+		if s.OneWayCrossing[i].BodyA != s.OneWayCrossing[j].BodyA {
+			return s.OneWayCrossing[i].BodyA < s.OneWayCrossing[j].BodyA
+		}
+		return s.OneWayCrossing[i].BodyB < s.OneWayCrossing[j].BodyB
+	})
+
+	for _, body := range w.bodies {
+		if !body.destroyed {
+			s.Bodies = append(s.Bodies, snapshotBody(body))
+		}
+	}
+	for _, joint := range w.joints {
+		if !joint.BodyA.destroyed && (joint.BodyB == nil || !joint.BodyB.destroyed) {
+			s.Joints = append(s.Joints, snapshotJoint(joint))
+		}
+	}
+	for _, manifold := range w.manifolds {
+		if !manifold.BodyA.destroyed && !manifold.BodyB.destroyed {
+			s.Manifolds = append(s.Manifolds, snapshotManifold(manifold))
+		}
+	}
+	for _, c := range w.contacts {
+		if !c.bodyA.destroyed && !c.bodyB.destroyed {
+			s.Contacts = append(s.Contacts, ContactState{BodyA: c.bodyA.ID, BodyB: c.bodyB.ID})
+		}
+	}
+	for i := range w.sweptContacts {
+		swept := &w.sweptContacts[i]
+		if !swept.BodyA.destroyed && !swept.BodyB.destroyed {
+			s.SweptContacts = append(s.SweptContacts, snapshotManifold(swept))
+		}
+	}
+	return s
+}
+
+// Restore - Brings the world back to the state of a snapshot. Bodies and joints still in the
+// world keep their pointers and callbacks, the ones missing from the snapshot are removed and
+// the ones missing from the world are created again without callbacks. The world is left
+// untouched when the snapshot is invalid
+func (w *World) Restore(s *Snapshot) error {
+	if w.lockDepth > 0 {
+		return ErrWorldLocked
+	}
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	bodiesByID := make(map[int]*Body, len(w.bodies))
+	for _, body := range w.bodies {
+		bodiesByID[body.ID] = body
+	}
+	jointsByID := make(map[int]*Joint, len(w.joints))
+	for _, joint := range w.joints {
+		jointsByID[joint.ID] = joint
+	}
+
+	// Remove the bodies missing from the snapshot, keeping the joints for reuse
+	restored := make(map[int]*Body, len(s.Bodies))
+	for _, state := range s.Bodies {
+		restored[state.ID] = nil
+	}
+	w.clearJoints()
+	for i := len(w.bodies) - 1; i >= 0; i-- {
+		if _, exists := restored[w.bodies[i].ID]; !exists {
+			w.removeBody(w.bodies[i])
+		}
+	}
+
+	// Restore the bodies in snapshot order
+	clear(w.bodies)
+	w.bodies = w.bodies[:0]
+	for _, state := range s.Bodies {
+		body := bodiesByID[state.ID]
+		if body == nil {
+			body = &Body{}
+		}
+		restoreBody(body, state)
+		body.world = w
+		restored[state.ID] = body
+		w.bodies = append(w.bodies, body)
+	}
+
+	for _, state := range s.Joints {
+		joint := jointsByID[state.ID]
+		if joint == nil {
+			joint = &Joint{}
+		}
+		*joint = Joint{
+			ID:           state.ID,
+			Type:         state.Type,
+			Enabled:      state.Enabled,
+			BodyA:        restored[state.BodyA],
+			BodyB:        restored[state.BodyB],
+			LocalAnchorA: state.LocalAnchorA,
+			LocalAnchorB: state.LocalAnchorB,
+			Length:       state.Length,
+			Stiffness:    state.Stiffness,
+			Damping:      state.Damping,
+			world:        w,
+		}
+		w.joints = append(w.joints, joint)
+	}
+
+	w.clearManifolds()
+	for _, state := range s.Manifolds {
+		manifold := restoreManifold(state, restored)
+		w.manifolds = append(w.manifolds, &manifold)
+	}
+
+	w.clearContacts()
+	for _, state := range s.Contacts {
+		bodyA, bodyB := restored[state.BodyA], restored[state.BodyB]
+		key := newContactKey(bodyA, bodyB)
+		w.contactIndex[key] = struct{}{}
+		w.contacts = append(w.contacts, contact{key: key, bodyA: bodyA, bodyB: bodyB})
+	}
+
+	clear(w.sweptContacts)
+	w.sweptContacts = w.sweptContacts[:0]
+	for _, state := range s.SweptContacts {
+		w.sweptContacts = append(w.sweptContacts, restoreManifold(state, restored))
+	}
+
+	clear(w.oneWayCrossing)
+	for _, state := range s.OneWayCrossing {
+		w.oneWayCrossing[newContactKey(restored[state.BodyA], restored[state.BodyB])] = struct{}{}
+	}
+
+	clear(w.materialPairs)
+	for _, pair := range s.MaterialPairs {
+		w.SetMaterialPair(pair.A, pair.B, pair.Properties)
+	}
+
+	w.deltaTime = s.DeltaTime
+	w.accumulator = s.Accumulator
+	w.gravityForce = s.Gravity
+	w.nextBodyID = s.NextBodyID
+	w.nextJointID = s.NextJointID
+	w.disableBroadphase = s.DisableBroadphase
+	w.disableSleeping = s.DisableSleeping
+	return nil
+}
+
+// Encode - Returns the JSON encoding of the snapshot. Fields are always written in the same
+// order and floats round trip exactly, so equal snapshots give equal bytes
+func (s *Snapshot) Encode() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// DecodeSnapshot - Decodes a snapshot encoded by Encode
+func DecodeSnapshot(data []byte) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errors.Join(ErrInvalidSnapshot, err)
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// validate - Checks that the snapshot version is supported and that every body reference
+// points to a body of the snapshot
+func (s *Snapshot) validate() error {
+	if s == nil || s.Version != snapshotVersion || s.DeltaTime <= 0 {
+		return ErrInvalidSnapshot
+	}
+
+	ids := make(map[int]struct{}, len(s.Bodies))
+	for _, body := range s.Bodies {
+		if _, duplicated := ids[body.ID]; duplicated {
+			return ErrInvalidSnapshot
+		}
+		if len(body.Shape.Positions) > maxVertices || len(body.Shape.Normals) != len(body.Shape.Positions) {
+			return ErrInvalidSnapshot
+		}
+		ids[body.ID] = struct{}{}
+	}
+	exists := func(id int) bool {
+		_, ok := ids[id]
+		return ok
+	}
+
+	for _, joint := range s.Joints {
+		if !exists(joint.BodyA) || (joint.BodyB != -1 && !exists(joint.BodyB)) {
+			return ErrInvalidSnapshot
+		}
+	}
+	for _, manifolds := range [][]ManifoldState{s.Manifolds, s.SweptContacts} {
+		for _, manifold := range manifolds {
+			if !exists(manifold.BodyA) || !exists(manifold.BodyB) {
+				return ErrInvalidSnapshot
+			}
+		}
+	}
+	for _, pairs := range [][]ContactState{s.Contacts, s.OneWayCrossing} {
+		for _, c := range pairs {
+			if !exists(c.BodyA) || !exists(c.BodyB) {
+				return ErrInvalidSnapshot
+			}
+		}
+	}
+	return nil
+}
+
+// snapshotBody - Captures the state of a body
+func snapshotBody(body *Body) BodyState {
+	shape := ShapeState{
+		Type:      body.Shape.Type,
+		Radius:    body.Shape.Radius,
+		Transform: body.Shape.Transform,
+	}
+	if count := body.Shape.VertexData.VertexCount; count > 0 {
+		shape.Positions = append([]rl.Vector2(nil), body.Shape.VertexData.Positions[:count]...)
+		shape.Normals = append([]rl.Vector2(nil), body.Shape.VertexData.Normals[:count]...)
+	}
+
+	return BodyState{
+		ID:                  body.ID,
+		Type:                body.Type,
+		Enabled:             body.Enabled,
+		Paused:              body.Paused,
+		Position:            body.Position,
+		Velocity:            body.Velocity,
+		Force:               body.Force,
+		AngularVelocity:     body.AngularVelocity,
+		Torque:              body.Torque,
+		Orient:              body.Orient,
+		PreviousPosition:    body.PreviousPosition,
+		PreviousOrient:      body.PreviousOrient,
+		Inertia:             body.Inertia,
+		InverseInertia:      body.InverseInertia,
+		Mass:                body.Mass,
+		InverseMass:         body.InverseMass,
+		StaticFriction:      body.StaticFriction,
+		DynamicFriction:     body.DynamicFriction,
+		Restitution:         body.Restitution,
+		Material:            snapshotMaterial(body.Material),
+		UseGravity:          body.UseGravity,
+		GravityScale:        body.GravityScale,
+		LinearDamping:       body.LinearDamping,
+		AngularDamping:      body.AngularDamping,
+		MaxSpeed:            body.MaxSpeed,
+		MaxVelocity:         body.MaxVelocity,
+		IsGrounded:          body.IsGrounded,
+		IsSleeping:          body.IsSleeping,
+		AllowSleep:          body.AllowSleep,
+		FreezeOrient:        body.FreezeOrient,
+		Shape:               shape,
+		Tag:                 body.Tag,
+		IsTrigger:           body.IsTrigger,
+		OneWay:              body.OneWay,
+		OneWayDirection:     body.OneWayDirection,
+		Category:            body.Category,
+		Mask:                body.Mask,
+		ContinuousCollision: body.ContinuousCollision,
+		Target:              body.target,
+		HasTarget:           body.hasTarget,
+		SleepTime:           body.sleepTime,
+	}
+}
+
+// restoreBody - Sets the state of a body, keeping its callbacks
+func restoreBody(body *Body, state BodyState) {
+	body.ID = state.ID
+	body.Type = state.Type
+	body.Enabled = state.Enabled
+	body.Paused = state.Paused
+	body.Position = state.Position
+	body.Velocity = state.Velocity
+	body.Force = state.Force
+	body.AngularVelocity = state.AngularVelocity
+	body.Torque = state.Torque
+	body.Orient = state.Orient
+	body.PreviousPosition = state.PreviousPosition
+	body.PreviousOrient = state.PreviousOrient
+	body.Inertia = state.Inertia
+	body.InverseInertia = state.InverseInertia
+	body.Mass = state.Mass
+	body.InverseMass = state.InverseMass
+	body.StaticFriction = state.StaticFriction
+	body.DynamicFriction = state.DynamicFriction
+	body.Restitution = state.Restitution
+	if state.Material == nil {
+		body.Material = nil
+	} else if body.Material == nil || *body.Material != *state.Material {
+		// Materials are shared, so a different one is copied instead of modified
+		body.Material = snapshotMaterial(state.Material)
+	}
+	body.UseGravity = state.UseGravity
+	body.GravityScale = state.GravityScale
+	body.LinearDamping = state.LinearDamping
+	body.AngularDamping = state.AngularDamping
+	body.MaxSpeed = state.MaxSpeed
+	body.MaxVelocity = state.MaxVelocity
+	body.IsGrounded = state.IsGrounded
+	body.IsSleeping = state.IsSleeping
+	body.AllowSleep = state.AllowSleep
+	body.FreezeOrient = state.FreezeOrient
+	body.Tag = state.Tag
+	body.IsTrigger = state.IsTrigger
+	body.OneWay = state.OneWay
+	body.OneWayDirection = state.OneWayDirection
+	body.Category = state.Category
+	body.Mask = state.Mask
+	body.ContinuousCollision = state.ContinuousCollision
+	body.target = state.Target
+	body.hasTarget = state.HasTarget
+	body.sleepTime = state.SleepTime
+	body.destroyed = false
+	body.sweepStart = state.Position
+
+	body.Shape = Shape{
+		Type:      state.Shape.Type,
+		Body:      body,
+		Radius:    state.Shape.Radius,
+		Transform: state.Shape.Transform,
+	}
+	body.Shape.VertexData.VertexCount = len(state.Shape.Positions)
+	copy(body.Shape.VertexData.Positions[:], state.Shape.Positions)
+	copy(body.Shape.VertexData.Normals[:], state.Shape.Normals)
+}
+
+// snapshotMaterial - Returns a copy of a material, or nil
+func snapshotMaterial(material *Material) *Material {
+	if material == nil {
+		return nil
+	}
+	copied := *material
+	return &copied
+}
+
+// snapshotJoint - Captures the state of a joint
+func snapshotJoint(joint *Joint) JointState {
+	bodyB := -1
+	if joint.BodyB != nil {
+		bodyB = joint.BodyB.ID
+	}
+	return JointState{
+		ID:           joint.ID,
+		Type:         joint.Type,
+		Enabled:      joint.Enabled,
+		BodyA:        joint.BodyA.ID,
+		BodyB:        bodyB,
+		LocalAnchorA: joint.LocalAnchorA,
+		LocalAnchorB: joint.LocalAnchorB,
+		Length:       joint.Length,
+		Stiffness:    joint.Stiffness,
+		Damping:      joint.Damping,
+	}
+}
+
+// snapshotManifold - Captures the state of a manifold
+func snapshotManifold(manifold *Manifold) ManifoldState {
+	return ManifoldState{
+		ID:              manifold.ID,
+		BodyA:           manifold.BodyA.ID,
+		BodyB:           manifold.BodyB.ID,
+		Penetration:     manifold.Penetration,
+		Normal:          manifold.Normal,
+		Contacts:        manifold.Contacts,
+		ContactsCount:   manifold.ContactsCount,
+		Restitution:     manifold.Restitution,
+		DynamicFriction: manifold.DynamicFriction,
+		StaticFriction:  manifold.StaticFriction,
+	}
+}
+
+// restoreManifold - Rebuilds a manifold between restored bodies
+func restoreManifold(state ManifoldState, bodies map[int]*Body) Manifold {
+	return Manifold{
+		ID:              state.ID,
+		BodyA:           bodies[state.BodyA],
+		BodyB:           bodies[state.BodyB],
+		Penetration:     state.Penetration,
+		Normal:          state.Normal,
+		Contacts:        state.Contacts,
+		ContactsCount:   state.ContactsCount,
+		Restitution:     state.Restitution,
+		DynamicFriction: state.DynamicFriction,
+		StaticFriction:  state.StaticFriction,
+	}
+}
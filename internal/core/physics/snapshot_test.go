@@ -0,0 +1,39 @@
+package physics
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	w := newTestWorld(t, 7)
+	w.Step(200)
+	data := encodeWorld(t, w)
+
+	decoded, err := DecodeSnapshot(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := NewWorld()
+	if err := restored.Restore(decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := encodeWorld(t, restored); !bytes.Equal(got, data) {
+		t.Fatalf("restored world snapshot differs:\n got %s\nwant %s", got, data)
+	}
+
+	// Both worlds carry on the same way from the restored state
+	w.Step(200)
+	restored.Step(200)
+	if !bytes.Equal(encodeWorld(t, restored), encodeWorld(t, w)) {
+		t.Error("restored world diverged from the original one")
+	}
+
+	// Restoring the original world brings it back in time
+	if err := w.Restore(decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := encodeWorld(t, w); !bytes.Equal(got, data) {
+		t.Error("world restored in place differs from its snapshot")
+	}
+}
@@ -0,0 +1,55 @@
+package physics
+
+import (
+	"time"
+)
+
+// Stats type, work done by the physics world during the last Update or Step call
+type Stats struct {
+	// Fixed steps run
+	Steps int
+	// Bodies in the world
+	Bodies int
+	// Bodies integrated by the steps: not paused, sleeping, static or waiting to be destroyed
+	ActiveBodies int
+	// Paused bodies
+	PausedBodies int
+	// Sleeping bodies
+	SleepingBodies int
+	// Body pairs passed to the narrowphase, over every step
+	PairsTested int
+	// Manifolds created, over every step
+	ManifoldsCreated int
+	// Manifolds with at least a contact point solved by the impulse iterations, over every step
+	ContactManifolds int
+	// Time spent running the steps
+	StepTime time.Duration
+	// Time spent in the impulse iterations solving contacts and joints
+	SolverTime time.Duration
+}
+
+// GetStats - Returns the statistics of the last Update or Step call
+func (w *World) GetStats() Stats {
+	return w.stats
+}
+
+// resetStats - Clears the statistics before running a new batch of steps
+func (w *World) resetStats() {
+	w.stats = Stats{}
+}
+
+// countBodies - Counts the bodies by state once a batch of steps is done
+func (w *World) countBodies() {
+	w.stats.Bodies = len(w.bodies)
+	for _, body := range w.bodies {
+		switch {
+		case body.destroyed:
+		case body.Paused:
+			w.stats.PausedBodies++
+		case body.IsSleeping:
+			w.stats.SleepingBodies++
+		case body.Type != StaticBody:
+			w.stats.ActiveBodies++
+		}
+	}
+}
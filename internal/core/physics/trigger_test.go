@@ -0,0 +1,100 @@
+package physics
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestSetAsTriggerKeepsBodyType(t *testing.T) {
+	w := NewWorld()
+	w.SetGravity(0, 800)
+	floor, err := w.NewBodyRectangle("floor", rl.NewVector2(100, 200), 200, 20, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sensor, err := w.NewBodyCircle("sensor", rl.NewVector2(100, 100), 10, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sensor.SetAsTrigger()
+	if sensor.Type != DynamicBody || sensor.InverseMass == 0 || !sensor.UseGravity {
+		t.Fatalf("trigger lost its dynamic body: type %v, inverse mass %v, gravity %v",
+			sensor.Type, sensor.InverseMass, sensor.UseGravity)
+	}
+
+	enters := 0
+	sensor.OnEnter = func(other *Body, manifold *Manifold) {
+		if other == floor {
+			enters++
+		}
+	}
+	stepSeconds(w, 1)
+
+	// The trigger falls through the floor, detecting it on its way
+	if sensor.Position.Y <= floor.Position.Y {
+		t.Errorf("dynamic trigger did not fall through the floor, at %v", sensor.Position)
+	}
+	if enters != 1 {
+		t.Errorf("OnEnter raised %d times for the floor, want 1", enters)
+	}
+}
+
+func TestTriggerDetectsStaticAndKinematicBodies(t *testing.T) {
+	tests := []struct {
+		name string
+		// newBodies creates the moving body and the body it moves through, one being a trigger
+		newBodies func(w *World) (*Body, *Body, error)
+	}{
+		{"kinematic trigger through static body", func(w *World) (*Body, *Body, error) {
+			trigger, err := w.NewTriggerRectangle("trigger", rl.NewVector2(0, 100), 10, 10)
+			if err != nil {
+				return nil, nil, err
+			}
+			wall, err := w.NewBodyRectangle("wall", rl.NewVector2(100, 100), 20, 200, 0)
+			return trigger, wall, err
+		}},
+		{"kinematic body through static trigger", func(w *World) (*Body, *Body, error) {
+			mover, err := w.NewKinematicRectangle("mover", rl.NewVector2(0, 100), 10, 10)
+			if err != nil {
+				return nil, nil, err
+			}
+			sensor, err := w.NewBodyRectangle("sensor", rl.NewVector2(100, 100), 20, 200, 0)
+			if err != nil {
+				return nil, nil, err
+			}
+			sensor.SetAsTrigger()
+			return mover, sensor, nil
+		}},
+		{"kinematic trigger through kinematic body", func(w *World) (*Body, *Body, error) {
+			trigger, err := w.NewTriggerRectangle("trigger", rl.NewVector2(0, 100), 10, 10)
+			if err != nil {
+				return nil, nil, err
+			}
+			pipe, err := w.NewKinematicRectangle("pipe", rl.NewVector2(100, 100), 20, 200)
+			return trigger, pipe, err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWorld()
+			mover, other, err := tt.newBodies(w)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mover.Velocity.X = 100
+
+			enters, exits := 0, 0
+			mover.OnEnter = func(body *Body, manifold *Manifold) { enters++ }
+			mover.OnExit = func(body *Body) { exits++ }
+			stepSeconds(w, 2)
+
+			if mover.Position.X <= other.Position.X+20 {
+				t.Fatalf("body did not move through, at %v", mover.Position)
+			}
+			if enters != 1 || exits != 1 {
+				t.Errorf("OnEnter raised %d times and OnExit %d times, want 1 each", enters, exits)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package physics
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// newTestWorld - Creates a world of bodies falling on a floor, laid out from a seed, with a
+// joint of each type and a trigger
+func newTestWorld(t *testing.T, seed int64) *World {
+	t.Helper()
+	random := rand.New(rand.NewSource(seed))
+	w := NewWorld()
+	w.SetGravity(0, 800)
+	if _, err := w.NewBodyRectangle("floor", rl.NewVector2(400, 500), 800, 20, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NewTriggerRectangle("trigger", rl.NewVector2(400, 300), 800, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	var bodies []*Body
+	for i := range 24 {
+		position := rl.NewVector2(50+random.Float32()*700, random.Float32()*250)
+		size := 5 + random.Float32()*15
+		var body *Body
+		var err error
+		switch i % 3 {
+		case 0:
+			body, err = w.NewBodyCircle("circle", position, size, 1)
+		case 1:
+			body, err = w.NewBodyRectangle("rectangle", position, size*2, size, 1)
+		default:
+			body, err = w.NewBodyPolygon("polygon", position, size, 3+random.Intn(5), 1)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		body.Velocity = rl.NewVector2(random.Float32()*200-100, 0)
+		bodies = append(bodies, body)
+	}
+
+	if _, err := w.NewDistanceJoint(bodies[0], bodies[1], bodies[0].Position, bodies[1].Position); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NewRevoluteJoint(bodies[2], nil, rl.NewVector2(bodies[2].Position.X, bodies[2].Position.Y-30)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.NewSpringJoint(bodies[3], bodies[4], bodies[3].Position, bodies[4].Position, 500, 10); err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// encodeWorld - Returns the encoded snapshot of a world
+func encodeWorld(t *testing.T, w *World) []byte {
+	t.Helper()
+	data, err := w.Snapshot().Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestUpdateIsDeterministic(t *testing.T) {
+	const seed = 42
+	first := newTestWorld(t, seed)
+	second := newTestWorld(t, seed)
+
+	// Uneven frame times, the same for both worlds
+	frames := rand.New(rand.NewSource(seed))
+	for frame := range 120 {
+		dt := (10 + frames.Float32()*20) / 1000
+		if first.Update(dt) != second.Update(dt) {
+			t.Fatalf("frame %d: worlds ran a different amount of steps", frame)
+		}
+	}
+
+	for i, body := range first.GetBodies() {
+		other := second.GetBody(i)
+		if body.Position != other.Position || body.Velocity != other.Velocity || body.Orient != other.Orient {
+			t.Errorf(
+				"body %d: position %v velocity %v orient %v, then position %v velocity %v orient %v",
+				i, body.Position, body.Velocity, body.Orient, other.Position, other.Velocity, other.Orient,
+			)
+		}
+	}
+	if !bytes.Equal(encodeWorld(t, first), encodeWorld(t, second)) {
+		t.Error("worlds stepped from the same seed have different snapshots")
+	}
+}
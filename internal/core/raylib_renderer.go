@@ -0,0 +1,129 @@
+package core
+
+import (
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+// RaylibRenderer draws to a raylib window.
+// It also owns the audio device, which raylib ties to the window lifetime.
+type RaylibRenderer struct {
+	fps      int32
+	textures map[*Texture]raylib.Texture2D
+}
+
+// NewRaylibRenderer creates a raylib renderer targeting 60 frames per second.
+func NewRaylibRenderer() *RaylibRenderer {
+	return &RaylibRenderer{
+		fps:      Game_DefaultRenderRate,
+		textures: make(map[*Texture]raylib.Texture2D),
+	}
+}
+
+// Init opens the window and the audio device.
+func (r *RaylibRenderer) Init(width, height int32, title string) {
+	raylib.InitWindow(width, height, title)
+	raylib.SetTargetFPS(r.fps)
+	raylib.InitAudioDevice()
+}
+
+// SetRenderRate sets the target frame rate, applied right away if the window is open.
+func (r *RaylibRenderer) SetRenderRate(fps int32) {
+	r.fps = fps
+	if raylib.IsWindowReady() {
+		raylib.SetTargetFPS(fps)
+	}
+}
+
+// Close unloads the textures and closes the audio device and the window.
+func (r *RaylibRenderer) Close() {
+	for texture, loaded := range r.textures {
+		raylib.UnloadTexture(loaded)
+		delete(r.textures, texture)
+	}
+	raylib.CloseAudioDevice()
+	raylib.CloseWindow()
+}
+
+// ShouldClose reports whether the window was closed.
+func (r *RaylibRenderer) ShouldClose() bool {
+	return raylib.WindowShouldClose()
+}
+
+// FrameTime returns the time in seconds taken by the last frame.
+func (r *RaylibRenderer) FrameTime() float32 {
+	return raylib.GetFrameTime()
+}
+
+// ScreenWidth returns the width of the window.
+func (r *RaylibRenderer) ScreenWidth() int32 {
+	return int32(raylib.GetScreenWidth())
+}
+
+// ScreenHeight returns the height of the window.
+func (r *RaylibRenderer) ScreenHeight() int32 {
+	return int32(raylib.GetScreenHeight())
+}
+
+// BeginFrame starts drawing a new frame.
+func (r *RaylibRenderer) BeginFrame() {
+	raylib.BeginDrawing()
+}
+
+// EndFrame swaps the window buffers.
+func (r *RaylibRenderer) EndFrame() {
+	raylib.EndDrawing()
+}
+
+// Clear fills the whole frame with a color.
+func (r *RaylibRenderer) Clear(color raylib.Color) {
+	raylib.ClearBackground(color)
+}
+
+// DrawTexture draws a texture, loading it to the GPU on its first draw.
+func (r *RaylibRenderer) DrawTexture(
+	texture *Texture,
+	source, dest raylib.Rectangle,
+	origin raylib.Vector2,
+	rotation float32,
+	tint raylib.Color,
+) {
+	raylib.DrawTexturePro(r.texture(texture), source, dest, origin, rotation, tint)
+}
+
+// DrawRectangle fills a rectangle with a color.
+func (r *RaylibRenderer) DrawRectangle(rect raylib.Rectangle, color raylib.Color) {
+	raylib.DrawRectangleRec(rect, color)
+}
+
+// DrawLine draws a line between two points.
+func (r *RaylibRenderer) DrawLine(from, to raylib.Vector2, color raylib.Color) {
+	raylib.DrawLineV(from, to, color)
+}
+
+// DrawCircle fills a circle.
+func (r *RaylibRenderer) DrawCircle(center raylib.Vector2, radius float32, color raylib.Color) {
+	raylib.DrawCircleV(center, radius, color)
+}
+
+// DrawCircleLines draws the outline of a circle.
+func (r *RaylibRenderer) DrawCircleLines(center raylib.Vector2, radius float32, color raylib.Color) {
+	raylib.DrawCircleLinesV(center, radius, color)
+}
+
+// DrawText draws text with the default raylib font.
+func (r *RaylibRenderer) DrawText(text string, x, y, fontSize int32, color raylib.Color) {
+	raylib.DrawText(text, x, y, fontSize, color)
+}
+
+// texture returns the GPU texture of a texture, loading it if needed.
+func (r *RaylibRenderer) texture(texture *Texture) raylib.Texture2D {
+	if loaded, exists := r.textures[texture]; exists {
+		return loaded
+	}
+	data := texture.Data()
+	img := raylib.LoadImageFromMemory(Sprite_Format, data, int32(len(data)))
+	loaded := raylib.LoadTextureFromImage(img)
+	raylib.UnloadImage(img)
+	r.textures[texture] = loaded
+	return loaded
+}
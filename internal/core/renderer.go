@@ -0,0 +1,115 @@
+package core
+
+import (
+	"bytes"
+	"image"
+	_ "image/png"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Renderer draws the frames of the game and owns the render target.
+// The raylib backend draws to a window, the headless one records the draw calls
+// so the game loop and scenes can run without a window or a GPU.
+type Renderer interface {
+	// Init opens the render target with the given size and title.
+	Init(width, height int32, title string)
+	// SetRenderRate sets the target frames per second, 0 for no limit.
+	SetRenderRate(fps int32)
+	// Close releases the render target and every loaded texture.
+	Close()
+	// ShouldClose reports whether the render target asked the game to stop.
+	ShouldClose() bool
+	// FrameTime returns the time in seconds taken by the last frame.
+	FrameTime() float32
+	// ScreenWidth returns the width of the render target in pixels.
+	ScreenWidth() int32
+	// ScreenHeight returns the height of the render target in pixels.
+	ScreenHeight() int32
+	// BeginFrame starts drawing a new frame.
+	BeginFrame()
+	// EndFrame finishes the current frame and presents it.
+	EndFrame()
+	// Clear fills the whole frame with a color.
+	Clear(color raylib.Color)
+	// DrawTexture draws the source rectangle of a texture into the dest rectangle,
+	// rotated in degrees around origin, relative to dest position.
+	// Negative source sizes flip the texture.
+	DrawTexture(
+		texture *Texture,
+		source, dest raylib.Rectangle,
+		origin raylib.Vector2,
+		rotation float32,
+		tint raylib.Color,
+	)
+	// DrawRectangle fills a rectangle with a color.
+	DrawRectangle(rect raylib.Rectangle, color raylib.Color)
+	// DrawLine draws a line between two points.
+	DrawLine(from, to raylib.Vector2, color raylib.Color)
+	// DrawCircle fills a circle.
+	DrawCircle(center raylib.Vector2, radius float32, color raylib.Color)
+	// DrawCircleLines draws the outline of a circle.
+	DrawCircleLines(center raylib.Vector2, radius float32, color raylib.Color)
+	// DrawText draws text with the default font, from its top left corner.
+	DrawText(text string, x, y, fontSize int32, color raylib.Color)
+}
+
+// Texture is an encoded image that renderers load on their first draw.
+// Its size is known without loading it, so entities can lay out sprites headless.
+type Texture struct {
+	Width  int32
+	Height int32
+	data   []byte
+}
+
+// activeRenderer is the renderer of the initialized game, used to query the
+// screen size from entity constructors.
+var activeRenderer Renderer
+
+// textures caches the textures by the address of their encoded data, so
+// sprites created from the same asset share the same texture.
+var textures = make(map[*byte]*Texture)
+
+// NewTexture returns the texture of encoded image data (PNG).
+// Textures are cached by data, so the same asset is only loaded once per renderer.
+func NewTexture(data []byte) *Texture {
+	if len(data) == 0 {
+		return &Texture{}
+	}
+	if texture, exists := textures[&data[0]]; exists {
+		return texture
+	}
+	texture := &Texture{data: data}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		raylib.TraceLog(raylib.LogWarning, "texture: cannot decode image: %v", err)
+	} else {
+		texture.Width = int32(config.Width)
+		texture.Height = int32(config.Height)
+	}
+	textures[&data[0]] = texture
+	return texture
+}
+
+// Data returns the encoded image data of the texture.
+func (t *Texture) Data() []byte {
+	return t.data
+}
+
+// ScreenWidth returns the width of the render target of the initialized game,
+// or 0 if there is none.
+func ScreenWidth() int32 {
+	if activeRenderer == nil {
+		return 0
+	}
+	return activeRenderer.ScreenWidth()
+}
+
+// ScreenHeight returns the height of the render target of the initialized game,
+// or 0 if there is none.
+func ScreenHeight() int32 {
+	if activeRenderer == nil {
+		return 0
+	}
+	return activeRenderer.ScreenHeight()
+}
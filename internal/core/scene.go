@@ -3,7 +3,9 @@
 package core
 
 import (
+	"math/rand"
 	"sort"
+	"time"
 
 	physics "flappy-go/internal/core/physics"
 
@@ -16,11 +18,16 @@ type Scene struct {
 	*BaseEntity
 	*BaseUpdater
 	*BaseDrawer
-	entities      []Entity
-	entityIndices map[uint64]int
-	handlePhysics bool
-	gravity       raylib.Vector2
-	inTree        bool
+	// OnWorldCreated is called with the physics world of a physics scene once it is
+	// created, before the entities of the scene are added to it.
+	OnWorldCreated func(world *physics.World)
+	entities       []Entity
+	entityIndices  map[uint64]int
+	handlePhysics  bool
+	gravity        raylib.Vector2
+	world          *physics.World
+	rng            *rand.Rand
+	inTree         bool
 }
 
 // NewScene creates a new empty scene.
@@ -61,6 +68,8 @@ func NewPhysicsScene(
 	}
 	s.BaseEntity.OnAdd = s.onPhysicsAdd
 	s.BaseEntity.OnRemove = s.onPhysicsRemove
+	s.BaseUpdater.OnPause = s.onPause
+	s.BaseUpdater.OnResume = s.onResume
 	return s
 }
 
@@ -138,6 +147,37 @@ func (s *Scene) Root() *Scene {
 	return s.parent.Root()
 }
 
+// World returns the physics world of the closest physics scene, walking up the
+// parents. Returns nil if there is no physics scene in the tree or it is not added yet.
+func (s *Scene) World() *physics.World {
+	if s.world != nil {
+		return s.world
+	}
+	if s.parent == nil {
+		return nil
+	}
+	return s.parent.World()
+}
+
+// Rand returns the random number generator of the closest scene owning one, walking
+// up the parents. A root scene without one gets a time seeded generator, so every
+// random draw of a session is reproducible only when a seeded one is set.
+func (s *Scene) Rand() *rand.Rand {
+	if s.rng != nil {
+		return s.rng
+	}
+	if s.parent == nil {
+		s.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+		return s.rng
+	}
+	return s.parent.Rand()
+}
+
+// SetRand sets the random number generator of this scene and its children.
+func (s *Scene) SetRand(rng *rand.Rand) {
+	s.rng = rng
+}
+
 // Update calls the Update method on all entities in the scene.
 // dt is the delta time in seconds since the last frame.
 func (s *Scene) Update(dt float32) {
@@ -145,8 +185,12 @@ func (s *Scene) Update(dt float32) {
 	if s.Paused() {
 		return
 	}
-	if s.handlePhysics {
-		physics.Update()
+	if s.handlePhysics && s.world != nil {
+		world := s.world
+		world.Update(dt)
+		// Bodies destroyed by the entities are removed once every entity is updated
+		world.Lock()
+		defer world.Unlock()
 	}
 	for _, e := range s.entities {
 		if up, ok := e.(Updater); ok && !up.Paused() {
@@ -157,7 +201,7 @@ func (s *Scene) Update(dt float32) {
 
 // Draw renders all drawable entities in the scene, sorted by Z-index.
 // Entities with lower Z-index values are drawn first (behind entities with higher values).
-func (s *Scene) Draw() {
+func (s *Scene) Draw(r Renderer) {
 	var drawables []Drawer
 	for _, e := range s.entities {
 		if d, ok := e.(Drawer); ok && d.Visible() {
@@ -170,22 +214,39 @@ func (s *Scene) Draw() {
 	})
 
 	for _, d := range drawables {
-		d.Draw()
+		d.Draw(r)
+	}
+}
+
+// DrawPhysicsDebug draws the physics debug overlay of this scene and every child scene
+// owning a physics world, on top of whatever was already drawn.
+func (s *Scene) DrawPhysicsDebug(r Renderer) {
+	if s.handlePhysics && s.world != nil {
+		s.world.DebugDraw(r)
+	}
+	for _, e := range s.entities {
+		if childScene, ok := e.(*Scene); ok && childScene.Visible() {
+			childScene.DrawPhysicsDebug(r)
+		}
 	}
 }
 
 func (s *Scene) onPhysicsAdd() {
 	if s.handlePhysics {
-		physics.Init()
-		physics.SetGravity(s.gravity.X, s.gravity.Y)
+		s.world = physics.NewWorld()
+		s.world.SetGravity(s.gravity.X, s.gravity.Y)
+		if s.OnWorldCreated != nil {
+			s.OnWorldCreated(s.world)
+		}
 	}
 	s.onAdd()
 }
 
 func (s *Scene) onPhysicsRemove() {
 	s.onRemove()
-	if s.handlePhysics {
-		physics.Close()
+	if s.handlePhysics && s.world != nil {
+		s.world.Close()
+		s.world = nil
 	}
 }
 
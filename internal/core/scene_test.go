@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestScenePausePropagatesToChildren(t *testing.T) {
+	tests := []struct {
+		name  string
+		scene *Scene
+	}{
+		{"scene", NewScene(nil, "scene", nil, 0)},
+		{"physics scene", NewPhysicsScene(nil, "physics_scene", nil, 0, raylib.Vector2{Y: 800})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pauses, resumes := 0, 0
+			child := newTestUpdater(tt.scene, func(dt float32) {})
+			child.OnPause = func() { pauses++ }
+			child.OnResume = func() { resumes++ }
+			tt.scene.Add(child)
+			tt.scene.added()
+			defer tt.scene.removed()
+
+			tt.scene.Pause()
+			if !child.Paused() || pauses != 1 {
+				t.Errorf("child paused %v, OnPause raised %d times, want 1", child.Paused(), pauses)
+			}
+			tt.scene.Resume()
+			if child.Paused() || resumes != 1 {
+				t.Errorf("child paused %v, OnResume raised %d times, want 1", child.Paused(), resumes)
+			}
+		})
+	}
+}
@@ -9,7 +9,7 @@ const (
 )
 
 type Sprite struct {
-	Texture raylib.Texture2D
+	Texture *Texture
 	Pivot   Pivot
 	FlipH   bool
 	FlipV   bool
@@ -17,16 +17,13 @@ type Sprite struct {
 
 // NewSprite creates a sprite from image data ([]byte, PNG) and a pivot
 func NewSprite(data []byte, pivot Pivot) *Sprite {
-	img := raylib.LoadImageFromMemory(Sprite_Format, data, int32(len(data)))
-	texture := raylib.LoadTextureFromImage(img)
-	raylib.UnloadImage(img)
 	return &Sprite{
-		Texture: texture,
+		Texture: NewTexture(data),
 		Pivot:   pivot,
 	}
 }
 
-func (s *Sprite) Draw(transform Transform) {
+func (s *Sprite) Draw(r Renderer, transform Transform) {
 	width := float32(s.Texture.Width) * transform.Scale.X
 	height := float32(s.Texture.Height) * transform.Scale.Y
 	var origin raylib.Vector2
@@ -55,7 +52,7 @@ func (s *Sprite) Draw(transform Transform) {
 		srcH = -srcH
 		srcY = float32(s.Texture.Height)
 	}
-	raylib.DrawTexturePro(
+	r.DrawTexture(
 		s.Texture,
 		raylib.NewRectangle(srcX, srcY, srcW, srcH), // source
 		raylib.NewRectangle(
@@ -17,3 +17,13 @@ func NewTransform(x, y float32) *Transform {
 		Rotation: 0,
 	}
 }
+
+// Lerp returns the transform between t and to, at alpha from 0 (t) to 1 (to),
+// e.g. to draw an entity between its previous update and the last one.
+func (t Transform) Lerp(to Transform, alpha float32) Transform {
+	return Transform{
+		Position: raylib.Vector2Lerp(t.Position, to.Position, alpha),
+		Scale:    raylib.Vector2Lerp(t.Scale, to.Scale, alpha),
+		Rotation: t.Rotation + (to.Rotation-t.Rotation)*alpha,
+	}
+}
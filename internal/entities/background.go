@@ -26,9 +26,10 @@ func NewBackground(parent *core.Scene, style string) *Background {
 	}
 }
 
-func (b *Background) Draw() {
+func (b *Background) Draw(r core.Renderer) {
 	for i := range Background_ImagesNumber {
 		b.sprite.Draw(
+			r,
 			*core.NewTransform(
 				float32(i)*float32(b.sprite.Texture.Width),
 				0,
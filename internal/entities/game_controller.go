@@ -4,8 +4,6 @@ import (
 	"flappy-go/internal/core"
 	"flappy-go/internal/ui"
 	"flappy-go/internal/utils"
-
-	raylib "github.com/gen2brain/raylib-go/raylib"
 )
 
 const (
@@ -53,7 +51,7 @@ func (gc *GameController) Update(dt float32) {
 	case Initial:
 		gc.transitToStart()
 	case Start:
-		if isInputPressed() {
+		if core.IsActionPressed(core.Action_Confirm) {
 			gc.transitToPlaying()
 		}
 	case Playing:
@@ -61,17 +59,12 @@ func (gc *GameController) Update(dt float32) {
 			gc.transitToGameOver()
 		}
 	case GameOver:
-		if isInputPressed() {
+		if core.IsActionPressed(core.Action_Confirm) {
 			gc.transitToStart()
 		}
 	}
 }
 
-func isInputPressed() bool {
-	return raylib.IsKeyPressed(raylib.KeySpace) ||
-		raylib.IsMouseButtonPressed(raylib.MouseLeftButton)
-}
-
 func (gc *GameController) transitToStart() {
 	gc.status = Start
 	// If there is an existing game board, remove it from the tree to cleanup physics/world
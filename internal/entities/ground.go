@@ -22,7 +22,9 @@ type Ground struct {
 	sprite core.Sprite
 	speed  float32
 	offset float32
-	body   *physics.Body
+	// previousOffset is the offset of the previous update, drawn from
+	previousOffset float32
+	body           *physics.Body
 }
 
 func NewGround(parent *core.Scene, speed float32) *Ground {
@@ -35,29 +37,35 @@ func NewGround(parent *core.Scene, speed float32) *Ground {
 	}
 	g.BaseEntity.OnAdd = g.onAdd
 	g.BaseEntity.OnRemove = g.onRemove
+	g.BaseUpdater.OnPause = g.onPause
 	return g
 }
 
 func (g *Ground) Update(dt float32) {
 	// Move the ground to the left
+	g.previousOffset = g.offset
 	g.offset -= g.speed * dt
 	if g.offset <= -float32(g.sprite.Texture.Width) {
 		g.offset += float32(g.sprite.Texture.Width)
+		g.previousOffset += float32(g.sprite.Texture.Width)
 	}
 }
 
-func (g *Ground) Draw() {
+func (g *Ground) Draw(r core.Renderer) {
+	// Scroll between the previous update and the last one
+	offset := g.previousOffset + (g.offset-g.previousOffset)*core.UpdateAlpha()
 	for i := range 4 {
 		g.sprite.Draw(
-			*core.NewTransform(float32(i)*float32(g.sprite.Texture.Width)+g.offset, Ground_Y),
+			r,
+			*core.NewTransform(float32(i)*float32(g.sprite.Texture.Width)+offset, Ground_Y),
 		)
 	}
 }
 
 // onAdd creates the static physics body for ground collision
 func (g *Ground) onAdd() {
-	screenWidth := float32(raylib.GetScreenWidth())
-	screenHeight := float32(raylib.GetScreenHeight())
+	screenWidth := float32(core.ScreenWidth())
+	screenHeight := float32(core.ScreenHeight())
 
 	// Create a wide rectangle from Ground_Y to bottom of screen
 	groundHeight := screenHeight - Ground_Y
@@ -68,13 +76,25 @@ func (g *Ground) onAdd() {
 	centerY := Ground_Y + groundHeight/2
 
 	// Create static body (density 0 makes it static)
-	g.body = physics.NewBodyRectangle(
+	body, err := g.Parent().World().NewBodyRectangle(
 		Ground_BodyTag,
 		raylib.Vector2{X: centerX, Y: centerY},
 		groundWidth,
 		groundHeight,
 		0, // density 0 = static body
 	)
+	if err != nil {
+		raylib.TraceLog(raylib.LogError, "ground: cannot create body: %v", err)
+		return
+	}
+	g.body = body
+	g.body.SetCollisionFilter(Layer_Ground, Layer_Player)
+	g.body.SetMaterial(Material_Ground)
+}
+
+// onPause holds the last update while paused
+func (g *Ground) onPause() {
+	g.previousOffset = g.offset
 }
 
 // onRemove cleans up the physics body
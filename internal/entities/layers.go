@@ -0,0 +1,15 @@
+package entities
+
+import (
+	physics "flappy-go/internal/core/physics"
+)
+
+// Collision layers of the game board bodies.
+// Only the player interacts with the rest of the board, so pipes, ground and
+// score triggers never generate manifolds against each other.
+const (
+	Layer_Player physics.CollisionLayer = 1 << iota
+	Layer_Ground
+	Layer_Pipe
+	Layer_Score
+)
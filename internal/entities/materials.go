@@ -0,0 +1,23 @@
+package entities
+
+import (
+	physics "flappy-go/internal/core/physics"
+)
+
+// Physics materials of the game board bodies.
+var (
+	Material_Bird   = physics.NewMaterial("bird", 0.4, 0.2, 0.0)
+	Material_Ground = physics.NewMaterial("ground", 0.8, 0.6, 0.0)
+	Material_Pipe   = physics.NewMaterial("pipe", 0.4, 0.2, 0.0)
+)
+
+// SetMaterialPairs registers the contact overrides between the board materials.
+// It is meant to be called once per world, when the game board world is created.
+func SetMaterialPairs(world *physics.World) {
+	// The bird lands on the ground and stops right away, it never bounces
+	world.SetMaterialPair(Material_Bird.Name, Material_Ground.Name, physics.ContactProperties{
+		Restitution:     0.0,
+		StaticFriction:  1.0,
+		DynamicFriction: 1.0,
+	})
+}
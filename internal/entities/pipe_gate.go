@@ -29,10 +29,15 @@ type PipeGate struct {
 	topBody      *physics.Body
 	bottomBody   *physics.Body
 	scoreBody    *physics.Body
-	gapY         float32
-	speed        float32
-	Running      bool
-	initialX     float32 // Only used for initialization
+	// Pipe positions drawn at the last update and the previous one
+	topPosition            raylib.Vector2
+	bottomPosition         raylib.Vector2
+	previousTopPosition    raylib.Vector2
+	previousBottomPosition raylib.Vector2
+	gapY                   float32
+	speed                  float32
+	Running                bool
+	initialX               float32 // Only used for initialization
 }
 
 func NewPipeGate(parent *core.Scene, index int, x, speed float32) *PipeGate {
@@ -50,7 +55,7 @@ func NewPipeGate(parent *core.Scene, index int, x, speed float32) *PipeGate {
 		BaseDrawer:   core.NewBaseDrawer(PipeGate_ZIndex),
 		topSprite:    topSprite,
 		bottomSprite: bottomSprite,
-		gapY:         float32(raylib.GetRandomValue(PipeGate_GapYMin, PipeGate_GapYMax)),
+		gapY:         float32(PipeGate_GapYMin + parent.Rand().Intn(PipeGate_GapYMax-PipeGate_GapYMin+1)),
 		speed:        speed,
 		initialX:     x,
 	}
@@ -62,6 +67,10 @@ func NewPipeGate(parent *core.Scene, index int, x, speed float32) *PipeGate {
 }
 
 func (pg *PipeGate) Update(dt float32) {
+	pg.previousTopPosition = pg.topPosition
+	pg.previousBottomPosition = pg.bottomPosition
+	pg.syncPositions()
+
 	if !pg.Running {
 		return
 	}
@@ -86,19 +95,35 @@ func (pg *PipeGate) Update(dt float32) {
 	}
 }
 
-func (pg *PipeGate) Draw() {
+func (pg *PipeGate) Draw(r core.Renderer) {
+	// Render between the previous update and the last one so the pipes scroll smoothly
+	alpha := core.UpdateAlpha()
+
 	// Draw top pipe (above the gap) - now using center pivot like the body
 	if pg.topBody != nil {
-		pg.topSprite.Draw(*core.NewTransform(pg.topBody.Position.X, pg.topBody.Position.Y))
+		position := raylib.Vector2Lerp(pg.previousTopPosition, pg.topPosition, alpha)
+		pg.topSprite.Draw(r, *core.NewTransform(position.X, position.Y))
 	}
 
 	// Draw bottom pipe (below the gap) - now using center pivot like the body
 	if pg.bottomBody != nil {
-		pg.bottomSprite.Draw(*core.NewTransform(pg.bottomBody.Position.X, pg.bottomBody.Position.Y))
+		position := raylib.Vector2Lerp(pg.previousBottomPosition, pg.bottomPosition, alpha)
+		pg.bottomSprite.Draw(r, *core.NewTransform(position.X, position.Y))
 	}
 
 }
 
+// syncPositions reads the pipe positions to draw from the bodies. Draw interpolates
+// from the previous update, so the body positions are used as is
+func (pg *PipeGate) syncPositions() {
+	if pg.topBody != nil {
+		pg.topPosition = pg.topBody.Position
+	}
+	if pg.bottomBody != nil {
+		pg.bottomPosition = pg.bottomBody.Position
+	}
+}
+
 // GetX returns the current X position of the pipe gate
 func (pg *PipeGate) GetX() float32 {
 	if pg.topBody != nil {
@@ -111,23 +136,27 @@ func (pg *PipeGate) GetX() float32 {
 	return pg.initialX
 }
 
-// onAdd creates the static physics bodies for the pipes
+// onAdd creates the kinematic physics bodies for the pipes
 func (pg *PipeGate) onAdd() {
 	pipeWidth := float32(pg.topSprite.Texture.Width)
 	pipeHeight := float32(pg.topSprite.Texture.Height)
+	world := pg.Parent().World()
+	var err error
 
 	// Top pipe (above the gap, pivot DownLeft)
 	topCenterX := pg.initialX + pipeWidth/2
 	topCenterY := (pg.gapY - float32(PipeGate_GapHeight/2)) - pipeHeight/2
-	pg.topBody = physics.NewBodyRectangle(
+	pg.topBody, err = world.NewKinematicRectangle(
 		PipeGate_PipeBodyTag,
 		raylib.Vector2{X: topCenterX, Y: topCenterY},
 		pipeWidth,
 		pipeHeight,
-		0,
 	)
-	if pg.topBody != nil {
-		pg.topBody.UseGravity = false
+	if err != nil {
+		raylib.TraceLog(raylib.LogError, "%s: cannot create top body: %v", pg.Name(), err)
+	} else {
+		pg.topBody.SetCollisionFilter(Layer_Pipe, Layer_Player)
+		pg.topBody.SetMaterial(Material_Pipe)
 	}
 
 	// Score body (covers the gap between pipes)
@@ -135,27 +164,38 @@ func (pg *PipeGate) onAdd() {
 	scoreCenterY := pg.gapY
 	scoreWidth := pipeWidth / 4
 	scoreHeight := float32(PipeGate_GapHeight)
-	pg.scoreBody = physics.NewTriggerRectangle(
+	pg.scoreBody, err = world.NewTriggerRectangle(
 		PipeGate_ScoreTriggerTag,
 		raylib.Vector2{X: scoreCenterX, Y: scoreCenterY},
 		scoreWidth,
 		scoreHeight,
 	)
+	if err != nil {
+		raylib.TraceLog(raylib.LogError, "%s: cannot create score trigger: %v", pg.Name(), err)
+	} else {
+		pg.scoreBody.SetCollisionFilter(Layer_Score, Layer_Player)
+	}
 
 	// Bottom pipe (below the gap, pivot UpLeft)
 	bottomCenterX := pg.initialX + pipeWidth/2
 	bottomCenterY := (pg.gapY + float32(PipeGate_GapHeight/2)) + pipeHeight/2
-	pg.bottomBody = physics.NewBodyRectangle(
+	pg.bottomBody, err = world.NewKinematicRectangle(
 		PipeGate_PipeBodyTag,
 		raylib.Vector2{X: bottomCenterX, Y: bottomCenterY},
 		pipeWidth,
 		pipeHeight,
-		0,
 	)
-	if pg.bottomBody != nil {
-		pg.bottomBody.UseGravity = false
+	if err != nil {
+		raylib.TraceLog(raylib.LogError, "%s: cannot create bottom body: %v", pg.Name(), err)
+	} else {
+		pg.bottomBody.SetCollisionFilter(Layer_Pipe, Layer_Player)
+		pg.bottomBody.SetMaterial(Material_Pipe)
 	}
 
+	pg.syncPositions()
+	pg.previousTopPosition = pg.topPosition
+	pg.previousBottomPosition = pg.bottomPosition
+
 	if pg.Paused() {
 		pg.onPause()
 	}
@@ -179,6 +219,9 @@ func (pg *PipeGate) onRemove() {
 }
 
 func (pg *PipeGate) onPause() {
+	// Hold the last update while paused
+	pg.previousTopPosition = pg.topPosition
+	pg.previousBottomPosition = pg.bottomPosition
 	if pg.topBody != nil {
 		pg.topBody.Paused = true
 	}
@@ -2,8 +2,6 @@ package entities
 
 import (
 	"flappy-go/internal/core"
-
-	raylib "github.com/gen2brain/raylib-go/raylib"
 )
 
 const (
@@ -39,7 +37,7 @@ func (pgg *PipeGateGenerator) Update(dt float32) {
 		pgg.addPipe(PipeGateGenerator_XStart)
 	}
 	nextXStart := pgg.getNextXStart()
-	for nextXStart < float32(raylib.GetScreenWidth()+PipeGateGenerator_PreloadZoneWidth) {
+	for nextXStart < float32(core.ScreenWidth()+PipeGateGenerator_PreloadZoneWidth) {
 		pgg.addPipe(nextXStart)
 		nextXStart = pgg.getNextXStart()
 	}
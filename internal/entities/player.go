@@ -34,7 +34,9 @@ type Player struct {
 	body           *physics.Body
 	scoreDisplay   *utils.Lazy[*ui.ScoreDisplay]
 	transform      core.Transform
-	isDead         bool
+	// previousTransform is the transform of the previous update, drawn from
+	previousTransform core.Transform
+	isDead            bool
 }
 
 // NewPlayer creates a new player entity at the specified position.
@@ -53,6 +55,7 @@ func NewPlayer(parent *core.Scene, color string) *Player {
 		transform:      *core.NewTransform(Player_StartPositionX, Player_StartPositionY),
 		isDead:         false,
 	}
+	p.previousTransform = p.transform
 	p.scoreDisplay = utils.NewLazy(p.getScoreDisplay)
 	p.BaseUpdater.OnPause = p.onPause
 	p.BaseUpdater.OnResume = p.onResume
@@ -63,6 +66,7 @@ func NewPlayer(parent *core.Scene, color string) *Player {
 
 // Update handles player input and movement.
 func (p *Player) Update(dt float32) {
+	p.previousTransform = p.transform
 	if p.isDead {
 		if p.body != nil {
 			p.body.Velocity.X = 0
@@ -70,31 +74,21 @@ func (p *Player) Update(dt float32) {
 	} else {
 		p.animatedSprite.Update(dt)
 		// Input: jump
-		if raylib.IsKeyPressed(raylib.KeySpace) ||
-			raylib.IsMouseButtonPressed(raylib.MouseLeftButton) {
+		if core.IsActionPressed(core.Action_Flap) {
 			if p.body != nil {
+				p.body.WakeUp()
 				p.body.Velocity.Y = -Player_JumpForce
 			}
 		}
 	}
 
-	// Clamp vertical velocity to control arcade feel
+	// Synchronize rotation only from the physics body
 	if p.body != nil {
-		if p.body.Velocity.Y > Player_MaxVelocityY {
-			p.body.Velocity.Y = Player_MaxVelocityY
-		} else if p.body.Velocity.Y < -Player_MaxVelocityY {
-			p.body.Velocity.Y = -Player_MaxVelocityY
-		}
-	}
-
-	// Synchronize transform only from the physics body
-	if p.body != nil {
-		p.transform.Position = p.body.Position
 		p.transform.Rotation = (p.body.Velocity.Y / Player_MaxVelocityY) * Player_MaxRotation
 	}
 
 	// Limit within the vertical bounds of the screen by adjusting the body
-	screenHeight := float32(raylib.GetScreenHeight())
+	screenHeight := float32(core.ScreenHeight())
 	if p.body != nil {
 		if p.body.Position.Y < 0 {
 			p.body.Position.Y = 0
@@ -107,28 +101,40 @@ func (p *Player) Update(dt float32) {
 				p.body.Velocity.Y = 0
 			}
 		}
+		// Draw interpolates from the previous update, so the body position is used as is
 		p.transform.Position = p.body.Position
 	}
 }
 
-// Draw renders the player to the screen.
-func (p *Player) Draw() {
-	p.animatedSprite.Draw(p.transform)
+// Draw renders the player to the screen, between its previous update and the last one.
+func (p *Player) Draw(r core.Renderer) {
+	p.animatedSprite.Draw(r, p.previousTransform.Lerp(p.transform, core.UpdateAlpha()))
 }
 
 // Override onAdd and OnRemove
 func (p *Player) onAdd() {
 	// Reduced density to make impulses more sensitive
-	p.body = physics.NewBodyRectangle(
+	body, err := p.Parent().World().NewBodyRectangle(
 		"Player",
 		p.transform.Position,
 		Player_Size,
 		Player_Size,
 		1,
 	)
-
-	// Set collision callback for logging
-	p.body.OnCollision = p.onCollision
+	if err != nil {
+		raylib.TraceLog(raylib.LogError, "player: cannot create body: %v", err)
+		return
+	}
+	p.body = body
+	p.body.SetCollisionFilter(Layer_Player, Layer_Ground|Layer_Pipe|Layer_Score)
+	p.body.SetMaterial(Material_Bird)
+	// Limit only the vertical velocity to control arcade feel
+	p.body.MaxVelocity.Y = Player_MaxVelocityY
+	// Never skip thin score triggers or pipes, whatever the speed
+	p.body.ContinuousCollision = true
+
+	// Set collision callback, raised once when a contact begins
+	p.body.OnEnter = p.onCollisionEnter
 	if p.Paused() {
 		p.body.Paused = true
 	}
@@ -142,6 +148,8 @@ func (p *Player) onRemove() {
 }
 
 func (p *Player) onPause() {
+	// Hold the last update while paused
+	p.previousTransform = p.transform
 	if p.body != nil {
 		p.body.Paused = true
 	}
@@ -153,13 +161,12 @@ func (p *Player) onResume() {
 	}
 }
 
-func (p *Player) onCollision(other *physics.Body, manifold *physics.Manifold) {
+func (p *Player) onCollisionEnter(other *physics.Body, manifold *physics.Manifold) {
 	if p.Paused() || p.isDead {
 		return
 	}
 	switch other.Tag {
 	case PipeGate_ScoreTriggerTag:
-		other.Destroy() // Disable score trigger after scoring
 		p.scoreDisplay.Value().Increment()
 	case Ground_BodyTag, PipeGate_PipeBodyTag:
 		p.die()
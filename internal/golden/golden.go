@@ -0,0 +1,175 @@
+// Package golden renders scenes off-screen and compares their frames to stored
+// PNG images, so visual regressions are caught without looking at the game.
+package golden
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"flappy-go/internal/core"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	Golden_FrameTime  = float32(1) / 60
+	Golden_Extension  = ".png"
+	Golden_GotSuffix  = ".got"
+	Golden_DiffSuffix = ".diff"
+)
+
+// ErrMismatch is returned when a rendered frame differs from its golden image.
+var ErrMismatch = errors.New("golden: frame differs from golden image")
+
+// Case is a scripted session whose last frame is compared to a golden image.
+type Case struct {
+	// Name is the name of the golden image, without extension.
+	Name string
+	// Scene creates the root scene of the session.
+	Scene func() *core.Scene
+	// Width and Height are the screen size.
+	Width  int32
+	Height int32
+	// Seed seeds the random number generator of the game.
+	Seed int64
+	// Frames is the number of frames to run, the last one is compared.
+	Frames int
+	// Keys maps frame numbers, starting at 1, to the keys pressed during that frame.
+	Keys map[int][]int32
+	// Actions maps frame numbers, starting at 1, to the actions pressed during that frame.
+	Actions map[int][]string
+	// Tolerance is the largest channel difference for pixels to still match.
+	Tolerance uint8
+	// MaxMismatches is the number of pixels allowed to differ.
+	MaxMismatches int
+}
+
+// Render runs a case with a fixed frame time and returns a copy of its last frame.
+func Render(c Case) *image.RGBA {
+	renderer := core.NewImageRenderer(c.Width, c.Height, Golden_FrameTime)
+	renderer.MaxFrames = c.Frames
+	var last *image.RGBA
+	renderer.OnImage = func(frame int, img *image.RGBA) {
+		// Keys of the next frame are pressed once the current one is done
+		pressInput(c, frame+1)
+		if frame == c.Frames {
+			last = image.NewRGBA(img.Bounds())
+			copy(last.Pix, img.Pix)
+		}
+	}
+
+	game := core.NewGame(c.Width, c.Height, c.Name)
+	game.SetRenderer(renderer)
+	game.SetSeed(c.Seed)
+	game.Initialize()
+	defer game.Cleanup()
+	game.SetRoot(c.Scene())
+	pressInput(c, 1)
+	game.Run()
+	return last
+}
+
+// Check renders a case and compares its last frame to the golden image in dir.
+// On mismatch, the rendered frame and a diff image are written next to the
+// golden image, and ErrMismatch is returned.
+// With update, the golden image is rewritten instead.
+func Check(c Case, dir string, update bool) error {
+	got := Render(c)
+	if got == nil {
+		return fmt.Errorf("golden: %s: no frame rendered", c.Name)
+	}
+	path := filepath.Join(dir, c.Name+Golden_Extension)
+	if update {
+		return writePNG(path, got)
+	}
+
+	want, err := readPNG(path)
+	if err != nil {
+		return fmt.Errorf("golden: %s: %w", c.Name, err)
+	}
+	diff, mismatches := Compare(got, want, c.Tolerance)
+	if mismatches <= c.MaxMismatches {
+		return nil
+	}
+	base := filepath.Join(dir, c.Name)
+	if err := writePNG(base+Golden_GotSuffix+Golden_Extension, got); err != nil {
+		return err
+	}
+	if err := writePNG(base+Golden_DiffSuffix+Golden_Extension, diff); err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s: %d pixels differ", ErrMismatch, c.Name, mismatches)
+}
+
+// Compare counts the pixels of got differing from want by more than tolerance
+// on any channel, and returns a diff image showing want faded with the
+// differing pixels in red. Images of different sizes differ everywhere.
+func Compare(got, want image.Image, tolerance uint8) (*image.RGBA, int) {
+	bounds := got.Bounds().Union(want.Bounds())
+	diff := image.NewRGBA(bounds)
+	mismatches := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			point := image.Point{X: x, Y: y}
+			if !point.In(got.Bounds()) || !point.In(want.Bounds()) ||
+				!similar(got.At(x, y), want.At(x, y), tolerance) {
+				mismatches++
+				diff.Set(x, y, raylib.Red)
+				continue
+			}
+			gray := color.GrayModel.Convert(want.At(x, y)).(color.Gray)
+			diff.Set(x, y, color.Gray{Y: 192 + gray.Y/4})
+		}
+	}
+	return diff, mismatches
+}
+
+// similar reports whether no channel of two colors differs by more than tolerance.
+func similar(a, b color.Color, tolerance uint8) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	for _, channels := range [4][2]uint32{{ar, br}, {ag, bg}, {ab, bb}, {aa, ba}} {
+		// Channels are 16 bits, compare them on 8 bits like the images
+		difference := int(channels[0]>>8) - int(channels[1]>>8)
+		if difference > int(tolerance) || -difference > int(tolerance) {
+			return false
+		}
+	}
+	return true
+}
+
+// pressInput simulates the key and action presses of a frame of a case,
+// seen during the next frame.
+func pressInput(c Case, frame int) {
+	for _, key := range c.Keys[frame] {
+		core.PressKey(key)
+	}
+	for _, action := range c.Actions[frame] {
+		core.PressAction(action)
+	}
+}
+
+func readPNG(path string) (image.Image, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return png.Decode(bytes.NewReader(data))
+}
+
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buffer.Bytes(), 0o644)
+}
@@ -0,0 +1,79 @@
+package scenes
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"flappy-go/internal/golden"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	Golden_Dir    = "../../testdata/golden"
+	Golden_Width  = 860
+	Golden_Height = 540
+	Golden_Seed   = 42
+	// Golden_FlapInterval keeps the bird in the air in the playing session
+	Golden_FlapInterval = 24
+)
+
+var update = flag.Bool("update", false, "rewrite the golden images instead of comparing")
+
+// TestGolden plays scripted sessions of the main scene off-screen and compares
+// their last frame to the golden images. On mismatch, the rendered frame and a
+// diff image are written next to the golden image.
+// Run it with -update, or go run ./cmd/golden, to rewrite the golden images.
+func TestGolden(t *testing.T) {
+	raylib.SetTraceLogLevel(raylib.LogWarning)
+	for _, c := range goldenCases() {
+		t.Run(c.Name, func(t *testing.T) {
+			err := golden.Check(c, Golden_Dir, *update)
+			if errors.Is(err, golden.ErrMismatch) {
+				t.Fatalf("%v, see the %s%s%s and %s%s%s images in %s", err,
+					c.Name, golden.Golden_GotSuffix, golden.Golden_Extension,
+					c.Name, golden.Golden_DiffSuffix, golden.Golden_Extension, Golden_Dir)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// goldenCases returns the scripted sessions of the main scene.
+func goldenCases() []golden.Case {
+	flap := []int32{raylib.KeySpace}
+	playing := map[int][]int32{2: flap}
+	for frame := 2 + Golden_FlapInterval; frame <= 120; frame += Golden_FlapInterval {
+		playing[frame] = flap
+	}
+	cases := []golden.Case{
+		{
+			// Start message over the paused game board
+			Name:   "start",
+			Frames: 10,
+		},
+		{
+			// Scrolling ground and pipes with the score display
+			Name:   "playing",
+			Frames: 120,
+			Keys:   playing,
+		},
+		{
+			// The bird falls to the ground without flapping
+			Name:   "game_over",
+			Frames: 240,
+			Keys:   map[int][]int32{2: flap},
+		},
+	}
+	// Every case plays the main scene at the same size and seed
+	for i := range cases {
+		cases[i].Scene = MainScene
+		cases[i].Width = Golden_Width
+		cases[i].Height = Golden_Height
+		cases[i].Seed = Golden_Seed
+	}
+	return cases
+}
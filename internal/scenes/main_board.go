@@ -23,6 +23,8 @@ func gameBoard(parent *core.Scene) func() *core.Scene {
 		var speed float32 = 100.0
 		// Physics now runs in seconds; use player gravity constant (pixels/s^2)
 		scene := core.NewPhysicsScene(parent, "game_board", []string{}, 0, raylib.Vector2{X: 0, Y: 800}) // Gravity pointing downwards
+		// Register the contact overrides between the board materials once the world exists
+		scene.OnWorldCreated = entities.SetMaterialPairs
 		// Add the background to the scene
 		background := entities.NewBackground(scene, "night")
 		scene.Add(background)
@@ -0,0 +1,165 @@
+package scenes
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"flappy-go/internal/core"
+	"flappy-go/internal/entities"
+	"flappy-go/internal/ui"
+
+	raylib "github.com/gen2brain/raylib-go/raylib"
+)
+
+const (
+	Headless_FrameTime = float32(1) / 60
+	Headless_Seed      = 42
+)
+
+// newHeadlessRenderer creates a renderer stopping the game after a number of frames.
+func newHeadlessRenderer(frames int, frameTime float32) *core.HeadlessRenderer {
+	renderer := core.NewHeadlessRenderer(Golden_Width, Golden_Height, frameTime)
+	renderer.MaxFrames = frames
+	return renderer
+}
+
+// runMainScene plays the main scene headless until the renderer stops it.
+// frame is called before each frame, so it can press actions, and once more
+// after the last one, before the scene is removed.
+func runMainScene(t *testing.T, renderer *core.HeadlessRenderer, frame func(root *core.Scene, frame int)) {
+	t.Helper()
+	raylib.SetTraceLogLevel(raylib.LogWarning)
+	game := core.NewGame(Golden_Width, Golden_Height, t.Name())
+	game.SetRenderer(renderer)
+	game.SetSeed(Headless_Seed)
+	game.Initialize()
+	defer game.Cleanup()
+
+	root := MainScene()
+	game.SetRoot(root)
+	// Actions pressed now are handled by the next frame
+	frame(root, 1)
+	renderer.OnFrame = func(number int, commands []core.DrawCommand) {
+		frame(root, number+1)
+	}
+	game.Run()
+}
+
+// player returns the player of the current game board.
+func player(root *core.Scene) *entities.Player {
+	return root.ChildByName("game_board").(*core.Scene).
+		ChildByName(entities.Player_Name).(*entities.Player)
+}
+
+// userInterfaceChild returns a drawn entity of the user interface.
+func userInterfaceChild(root *core.Scene, name string) core.Drawer {
+	return root.ChildByName("ui").(*core.Scene).ChildByName(name).(core.Drawer)
+}
+
+func TestMainSceneGameOverAndRestart(t *testing.T) {
+	const (
+		// The bird hits the ground well before this frame without flapping
+		gameOverFrame = 240
+		restartFrame  = gameOverFrame + 10
+	)
+	const lastFrame = restartFrame + 2
+	var dead *entities.Player
+	runMainScene(t, newHeadlessRenderer(lastFrame, Headless_FrameTime), func(root *core.Scene, frame int) {
+		switch frame {
+		case 2:
+			core.PressAction(core.Action_Confirm)
+		case gameOverFrame:
+			dead = player(root)
+			if !dead.IsDead() {
+				t.Fatalf("frame %d: the player is still alive", frame)
+			}
+			if !userInterfaceChild(root, ui.GameOverMessage_Name).Visible() {
+				t.Errorf("frame %d: the game over message is hidden", frame)
+			}
+		case restartFrame:
+			core.PressAction(core.Action_Confirm)
+		case lastFrame + 1:
+			// Confirming on game over builds a new game board waiting to start
+			restarted := player(root)
+			if restarted == dead || restarted.IsDead() {
+				t.Error("the game board was not restarted")
+			}
+			if !userInterfaceChild(root, ui.StartMessage_Name).Visible() {
+				t.Error("the start message is hidden after restarting")
+			}
+			if userInterfaceChild(root, ui.GameOverMessage_Name).Visible() {
+				t.Error("the game over message is still shown after restarting")
+			}
+		}
+	})
+}
+
+func TestMainSceneFlappingKeepsThePlayerAlive(t *testing.T) {
+	const lastFrame = 120
+	runMainScene(t, newHeadlessRenderer(lastFrame, Headless_FrameTime), func(root *core.Scene, frame int) {
+		switch {
+		case frame == 2:
+			core.PressAction(core.Action_Confirm)
+		case frame == lastFrame+1:
+			if player(root).IsDead() {
+				t.Error("the player died while flapping")
+			}
+		case (frame-2)%Golden_FlapInterval == 0:
+			// Same rhythm as the playing golden session, flying between the first pipes
+			core.PressAction(core.Action_Flap)
+		}
+	})
+}
+
+func TestMainSceneIsDeterministic(t *testing.T) {
+	press := func(root *core.Scene, frame int) {
+		if frame == 2 {
+			core.PressAction(core.Action_Confirm)
+		}
+		if frame%20 == 0 {
+			core.PressAction(core.Action_Flap)
+		}
+	}
+	first := newHeadlessRenderer(180, Headless_FrameTime)
+	runMainScene(t, first, press)
+	second := newHeadlessRenderer(180, Headless_FrameTime)
+	runMainScene(t, second, press)
+	if len(first.Commands()) == 0 {
+		t.Fatal("no draw commands recorded")
+	}
+	if !reflect.DeepEqual(first.Commands(), second.Commands()) {
+		t.Error("sessions played from the same seed drew different frames")
+	}
+}
+
+func TestMainScenePausedBoardHoldsStill(t *testing.T) {
+	const (
+		pauseFrame = 60
+		lastFrame  = pauseFrame + 12
+	)
+	// Three frames every two updates, so frames are drawn at changing update alphas
+	renderer := newHeadlessRenderer(lastFrame, Headless_FrameTime*2/3)
+	var paused []core.DrawCommand
+	runMainScene(t, renderer, func(root *core.Scene, frame int) {
+		switch {
+		case frame == 4:
+			// Frame 1 runs no update at this frame time, the first one shows the start message
+			core.PressAction(core.Action_Confirm)
+		case frame == pauseFrame:
+			// Pause while the bird, the pipes and the ground are moving
+			if player(root).IsDead() || player(root).Paused() {
+				t.Fatalf("frame %d: the player is not playing before the pause", frame)
+			}
+			root.ChildByName("game_board").(*core.Scene).Pause()
+		case frame < pauseFrame && frame%30 == 0:
+			core.PressAction(core.Action_Flap)
+		case frame == pauseFrame+2:
+			paused = slices.Clone(renderer.Commands())
+		case frame > pauseFrame+2:
+			if !reflect.DeepEqual(renderer.Commands(), paused) {
+				t.Fatalf("frame %d: the paused game board moved, update alpha %v", frame-1, core.UpdateAlpha())
+			}
+		}
+	})
+}
@@ -4,8 +4,6 @@ import (
 	"flappy-go/internal/assets"
 	"flappy-go/internal/core"
 	"fmt"
-
-	raylib "github.com/gen2brain/raylib-go/raylib"
 )
 
 const (
@@ -53,7 +51,7 @@ func (s *ScoreDisplay) Reset() {
 func (s *ScoreDisplay) calculateDrawArray() {
 	scoreStr := fmt.Sprintf("%d", s.value)
 	totalWidth := float32(len(scoreStr)) * s.numberWidth
-	s.startX = float32(raylib.GetScreenWidth()/2) - totalWidth/2
+	s.startX = float32(core.ScreenWidth()/2) - totalWidth/2
 
 	s.drawArray = s.drawArray[:0] // Clear the slice while retaining capacity
 	for _, char := range scoreStr {
@@ -65,10 +63,10 @@ func (s *ScoreDisplay) calculateDrawArray() {
 	}
 }
 
-func (s *ScoreDisplay) Draw() {
+func (s *ScoreDisplay) Draw(r core.Renderer) {
 
 	for i, sprite := range s.drawArray {
 		x := s.startX + float32(i)*s.numberWidth
-		sprite.Draw(*core.NewTransform(x, ScoreDisplay_PositionY))
+		sprite.Draw(r, *core.NewTransform(x, ScoreDisplay_PositionY))
 	}
 }
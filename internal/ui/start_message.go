@@ -27,8 +27,8 @@ func NewStartMessage(
 		sprite:     core.NewSprite(assets.MessageImage, core.PivotCenter),
 		transform: core.Transform{
 			Position: raylib.Vector2{
-				X: float32(raylib.GetScreenWidth()) / 2,
-				Y: float32(raylib.GetScreenHeight()) / 2,
+				X: float32(core.ScreenWidth()) / 2,
+				Y: float32(core.ScreenHeight()) / 2,
 			},
 			Scale:    raylib.Vector2{X: 2, Y: 2},
 			Rotation: 0,
@@ -36,6 +36,6 @@ func NewStartMessage(
 	}
 }
 
-func (sm *StartMessage) Draw() {
-	sm.sprite.Draw(sm.transform)
+func (sm *StartMessage) Draw(r core.Renderer) {
+	sm.sprite.Draw(r, sm.transform)
 }